@@ -0,0 +1,9 @@
+data "conjur_certificate_issuer" "shared" {
+  id = "my-cert-issuer"
+}
+
+# Reference the issuer instead of an unchecked string
+data "conjur_certificate_issue" "my_cert" {
+  issuer_name = data.conjur_certificate_issuer.shared.id
+  common_name = "app.example.com"
+}
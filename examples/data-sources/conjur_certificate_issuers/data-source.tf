@@ -0,0 +1,7 @@
+data "conjur_certificate_issuers" "aws" {
+  type = "aws"
+}
+
+output "aws_issuer_ids" {
+  value = data.conjur_certificate_issuers.aws.issuers[*].id
+}
@@ -0,0 +1,15 @@
+data "conjur_effective_access" "app" {
+  role       = "host:data/apps/app-1"
+  kind       = "variable"
+  privileges = ["execute"]
+}
+
+# Fail the plan if the host can fetch secrets outside its own branch
+check "app_secrets_scope" {
+  assert {
+    condition = alltrue([
+      for a in data.conjur_effective_access.app.access : startswith(a.name, "data/apps/app-1/")
+    ])
+    error_message = "app-1 can fetch secrets outside data/apps/app-1."
+  }
+}
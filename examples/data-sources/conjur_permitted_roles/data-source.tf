@@ -0,0 +1,9 @@
+# Every role that can fetch the database password
+data "conjur_permitted_roles" "db_password_readers" {
+  resource  = "variable:data/apps/db-password"
+  privilege = "execute"
+}
+
+output "db_password_hosts" {
+  value = [for r in data.conjur_permitted_roles.db_password_readers.roles : r.id if r.kind == "host"]
+}
@@ -0,0 +1,20 @@
+data "conjur_resource" "db_password" {
+  id = "variable:data/shared/db-password"
+}
+
+# Fail the plan if the shared variable is missing its owner or annotations
+check "db_password_metadata" {
+  assert {
+    condition     = data.conjur_resource.db_password.owner == "myaccount:policy:data/shared"
+    error_message = "db-password must be owned by the data/shared policy."
+  }
+
+  assert {
+    condition     = contains(keys(data.conjur_resource.db_password.annotations), "team")
+    error_message = "db-password must carry a team annotation."
+  }
+}
+
+output "db_password_versions" {
+  value = data.conjur_resource.db_password.secret_versions
+}
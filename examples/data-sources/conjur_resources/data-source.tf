@@ -0,0 +1,9 @@
+# Every variable the app-1 host can see
+data "conjur_resources" "app_variables" {
+  kind = "variable"
+  role = "host:data/apps/app-1"
+}
+
+output "app_variable_names" {
+  value = data.conjur_resources.app_variables.resources[*].name
+}
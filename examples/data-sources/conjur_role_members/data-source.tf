@@ -0,0 +1,8 @@
+data "conjur_role_members" "admins" {
+  role = "group:data/admins"
+}
+
+# Members that can grant the admins group to other roles
+output "admin_grantors" {
+  value = [for m in data.conjur_role_members.admins.members : m.member if m.admin_option]
+}
@@ -0,0 +1,11 @@
+data "conjur_role_memberships" "app" {
+  role = "host:data/apps/app-1"
+}
+
+# Fail the plan if the host ends up in the admins group through any chain of grants
+check "app_not_admin" {
+  assert {
+    condition     = !contains(data.conjur_role_memberships.app.all_memberships, "myaccount:group:data/admins")
+    error_message = "app-1 must not belong to the admins group."
+  }
+}
@@ -0,0 +1,22 @@
+# Values keyed by secret name
+data "conjur_secrets" "db" {
+  names = [
+    "data/db/username",
+    "data/db/password",
+  ]
+}
+
+# Base64 encoded values keyed by alias, tolerating variables that do not exist yet
+data "conjur_secrets" "app" {
+  variables = {
+    api_key = "data/app/api-key"
+    tls_key = "data/app/tls-key"
+  }
+  safe           = true
+  ignore_missing = true
+}
+
+output "db_username" {
+  value     = data.conjur_secrets.db.values["data/db/username"]
+  sensitive = true
+}
@@ -0,0 +1,6 @@
+ephemeral "conjur_certificate" "app" {
+  issuer_name = "my-cert-issuer"
+  common_name = "app.example.com"
+  dns_names   = ["app.example.com"]
+  ttl         = "PT1H"
+}
@@ -0,0 +1,14 @@
+ephemeral "conjur_certificate_issue" "workload" {
+  issuer_name = "my-cert-issuer"
+  common_name = "workload.example.com"
+  dns_names   = ["workload.example.com"]
+  ttl         = "PT1H"
+}
+
+# Pass the short-lived certificate and key to another provider without persisting them
+provider "kubernetes" {
+  host                   = "https://k8s.example.com"
+  client_certificate     = ephemeral.conjur_certificate_issue.workload.certificate
+  client_key             = ephemeral.conjur_certificate_issue.workload.private_key
+  cluster_ca_certificate = join("\n", ephemeral.conjur_certificate_issue.workload.chain)
+}
@@ -0,0 +1,5 @@
+ephemeral "conjur_certificate_sign" "workload" {
+  issuer_name = "my-cert-issuer"
+  csr         = trimspace(file("${path.module}/workload.csr.pem"))
+  ttl         = "PT1H"
+}
@@ -0,0 +1,8 @@
+ephemeral "conjur_host_factory_host" "app_host" {
+  token = ephemeral.conjur_host_factory_token.app_token.token
+  id    = "payments-3"
+
+  annotations = {
+    description = "Autoscaled payments host"
+  }
+}
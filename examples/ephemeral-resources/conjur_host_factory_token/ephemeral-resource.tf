@@ -0,0 +1,5 @@
+ephemeral "conjur_host_factory_token" "app_token" {
+  host_factory = conjur_host_factory.app_factory.full_id
+  duration     = "15m"
+  cidr         = ["10.0.0.0/24"]
+}
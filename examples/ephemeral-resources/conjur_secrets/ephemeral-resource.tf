@@ -0,0 +1,12 @@
+ephemeral "conjur_secrets" "db" {
+  variables = {
+    username = "data/db/username"
+    password = "data/db/password"
+  }
+}
+
+provider "postgresql" {
+  host     = "db.example.com"
+  username = ephemeral.conjur_secrets.db.values["username"]
+  password = ephemeral.conjur_secrets.db.values["password"]
+}
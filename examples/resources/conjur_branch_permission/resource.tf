@@ -0,0 +1,13 @@
+# Let the payments team read and fetch every variable under data/apps/payments,
+# including variables added to the branch later
+resource "conjur_branch_permission" "payments_secrets" {
+  role = {
+    name   = "payments-team"
+    kind   = "group"
+    branch = "data"
+  }
+
+  branch     = "data/apps/payments"
+  kind       = "variable"
+  privileges = ["read", "execute"]
+}
@@ -0,0 +1,11 @@
+resource "conjur_certificate" "app" {
+  issuer_name = "my-cert-issuer"
+  common_name = "app.example.com"
+  dns_names   = ["app.example.com", "www.example.com"]
+
+  key_type = "RSA_2048"
+  ttl      = "P90D"
+
+  # Re-issue the certificate 30 days before it expires
+  early_renewal = "720h"
+}
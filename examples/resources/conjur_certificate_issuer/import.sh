@@ -0,0 +1 @@
+terraform import conjur_certificate_issuer.aws aws-issuer
\ No newline at end of file
@@ -0,0 +1,10 @@
+resource "conjur_certificate_issuer" "aws" {
+  id      = "aws-issuer"
+  type    = "aws"
+  max_ttl = 3600
+
+  aws = {
+    access_key_id     = var.aws_access_key_id
+    secret_access_key = var.aws_secret_access_key
+  }
+}
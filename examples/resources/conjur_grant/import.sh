@@ -0,0 +1 @@
+terraform import conjur_grant.app_layer layer/data/apps/app-layer:host/data/apps/app-host
\ No newline at end of file
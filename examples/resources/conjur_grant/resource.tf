@@ -0,0 +1,31 @@
+# Add a host to a layer
+resource "conjur_grant" "app_layer" {
+  role = {
+    name   = "app-layer"
+    kind   = "layer"
+    branch = "data/apps"
+  }
+
+  member = {
+    name   = "app-host"
+    kind   = "host"
+    branch = "data/apps"
+  }
+}
+
+# Let the admins group manage the membership of the apps policy
+resource "conjur_grant" "apps_admins" {
+  role = {
+    name   = "apps"
+    kind   = "policy"
+    branch = "data"
+  }
+
+  member = {
+    name   = "admins"
+    kind   = "group"
+    branch = "data"
+  }
+
+  admin_option = true
+}
@@ -0,0 +1 @@
+terraform import conjur_group_members.test_users data/test/test-users
\ No newline at end of file
@@ -0,0 +1,14 @@
+resource "conjur_group_members" "test_users" {
+  group_id = "data/test/test-users"
+
+  members = [
+    {
+      kind = "user"
+      id   = "alice@data-test"
+    },
+    {
+      kind = "host"
+      id   = "data/test/my-workload"
+    },
+  ]
+}
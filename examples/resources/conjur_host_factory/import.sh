@@ -0,0 +1 @@
+terraform import conjur_host_factory.app_factory data/apps/app-factory
\ No newline at end of file
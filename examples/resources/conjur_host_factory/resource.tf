@@ -0,0 +1,8 @@
+resource "conjur_host_factory" "app_factory" {
+  name   = "app-factory"
+  branch = "data/apps"
+
+  layers = [
+    "data/apps/app-layer",
+  ]
+}
@@ -0,0 +1 @@
+terraform import conjur_layer.app_layer data/apps/app-layer
\ No newline at end of file
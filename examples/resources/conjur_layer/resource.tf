@@ -0,0 +1,13 @@
+resource "conjur_layer" "app_layer" {
+  name   = "app-layer"
+  branch = "data/apps"
+
+  annotations = {
+    description = "Hosts running the payments application"
+  }
+
+  hosts = [
+    "data/apps/payments-1",
+    "data/apps/payments-2",
+  ]
+}
@@ -11,3 +11,19 @@ resource "conjur_permission" "my_privileges" {
   }
   privileges = ["read", "execute"]
 }
+
+# Grant execute alongside privileges managed elsewhere, without denying them
+resource "conjur_permission" "shared_privileges" {
+  role = {
+    name   = "test-workload"
+    kind   = "host"
+    branch = "data/terraform/test"
+  }
+  resource = {
+    name   = "shared-secret"
+    kind   = "variable"
+    branch = "data/terraform/test"
+  }
+  privileges    = ["execute"]
+  authoritative = false
+}
@@ -0,0 +1,30 @@
+# Let two hosts read and fetch every database secret with a single policy load
+resource "conjur_permissions" "db_secrets" {
+  roles = [
+    {
+      name   = "app-1"
+      kind   = "host"
+      branch = "data/apps"
+    },
+    {
+      name   = "app-2"
+      kind   = "host"
+      branch = "data/apps"
+    },
+  ]
+
+  resources = [
+    {
+      name   = "db-username"
+      kind   = "variable"
+      branch = "data/secrets"
+    },
+    {
+      name   = "db-password"
+      kind   = "variable"
+      branch = "data/secrets"
+    },
+  ]
+
+  privileges = ["read", "execute"]
+}
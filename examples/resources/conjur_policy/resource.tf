@@ -0,0 +1,17 @@
+resource "conjur_policy" "apps" {
+  branch = "data/apps"
+  mode   = "replace"
+  policy = <<-EOT
+    - !group developers
+
+    - !host
+      id: build-agent
+
+    - !variable db-password
+
+    - !permit
+      role: !group developers
+      privileges: [ read, execute ]
+      resource: !variable db-password
+  EOT
+}
@@ -0,0 +1 @@
+terraform import conjur_user.alice data/users/alice
\ No newline at end of file
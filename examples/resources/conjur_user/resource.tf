@@ -0,0 +1,15 @@
+resource "conjur_user" "alice" {
+  name   = "alice"
+  branch = "data/users"
+
+  owner = {
+    kind = "group"
+    id   = "security-admins"
+  }
+
+  restricted_to = ["10.0.0.0/16"]
+
+  annotations = {
+    team = "platform"
+  }
+}
@@ -0,0 +1 @@
+terraform import conjur_variable.db_password data/apps/db-password
\ No newline at end of file
@@ -0,0 +1,19 @@
+resource "conjur_variable" "db_password" {
+  name      = "db-password"
+  branch    = "data/apps"
+  kind      = "password"
+  mime_type = "text/plain"
+
+  owner = {
+    kind = "group"
+    id   = "admins"
+  }
+
+  annotations = {
+    description = "Password for the payments database"
+  }
+
+  # Use value_wo to keep the value out of Terraform state, bump value_wo_version to rotate it
+  value_wo         = var.db_password
+  value_wo_version = 1
+}
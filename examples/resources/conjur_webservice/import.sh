@@ -0,0 +1 @@
+terraform import conjur_webservice.authn_jwt conjur/authn-jwt/my-service
\ No newline at end of file
@@ -0,0 +1,25 @@
+resource "conjur_webservice" "authn_jwt" {
+  name   = "my-service"
+  branch = "conjur/authn-jwt"
+
+  annotations = {
+    description = "JWT authenticator for CI workloads"
+  }
+}
+
+# Allow a group of workloads to authenticate through the authenticator
+resource "conjur_permission" "authn_jwt_users" {
+  role = {
+    name   = "apps"
+    kind   = "group"
+    branch = "conjur/authn-jwt/my-service"
+  }
+
+  resource = {
+    name   = conjur_webservice.authn_jwt.name
+    kind   = "webservice"
+    branch = conjur_webservice.authn_jwt.branch
+  }
+
+  privileges = ["read", "authenticate"]
+}
@@ -0,0 +1,113 @@
+package policy
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// batchWindow is how long PATCH policies for the same branch are collected before they are loaded together
+const batchWindow = 25 * time.Millisecond
+
+// patchBatcher merges the policies patched concurrently by resources of this process
+var patchBatcher = newBatcher(batchWindow)
+
+// batcher collects policies patched into the same branch with the same client and loads them with a single request
+type batcher struct {
+	window  time.Duration
+	mu      sync.Mutex
+	pending map[batchKey][]*batchRequest
+}
+
+type batchKey struct {
+	client api.ClientV2
+	branch string
+}
+
+type batchRequest struct {
+	policy string
+	result chan batchResult
+}
+
+type batchResult struct {
+	response *conjurapi.PolicyResponse
+	err      error
+}
+
+func newBatcher(window time.Duration) *batcher {
+	return &batcher{window: window, pending: map[batchKey][]*batchRequest{}}
+}
+
+// load queues a policy for the next load into its branch and waits for the result of that load.
+// The branch is trimmed of slashes the way lockBranch does, so spellings of the same branch share a batch.
+func (b *batcher) load(client api.ClientV2, policy, branch string) (*conjurapi.PolicyResponse, error) {
+	key := batchKey{client: client, branch: strings.Trim(branch, "/")}
+	request := &batchRequest{policy: policy, result: make(chan batchResult, 1)}
+
+	b.mu.Lock()
+	b.pending[key] = append(b.pending[key], request)
+	if len(b.pending[key]) == 1 {
+		time.AfterFunc(b.window, func() { b.flush(key) })
+	}
+	b.mu.Unlock()
+
+	result := <-request.result
+	return result.response, result.err
+}
+
+// flush loads the policies queued for a branch as one policy and hands the result to every caller
+func (b *batcher) flush(key batchKey) {
+	b.mu.Lock()
+	batch := b.pending[key]
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	if len(batch) == 1 {
+		response, err := LoadPolicy(key.client, conjurapi.PolicyModePatch, batch[0].policy, key.branch)
+		batch[0].result <- batchResult{response: response, err: err}
+		return
+	}
+
+	policies := make([]string, 0, len(batch))
+	for _, request := range batch {
+		policies = append(policies, request.policy)
+	}
+	tflog.Debug(context.Background(), "Loading batched policies", map[string]interface{}{
+		"branch":   key.branch,
+		"policies": len(batch),
+	})
+
+	response, err := LoadPolicy(key.client, conjurapi.PolicyModePatch, mergePolicies(policies), key.branch)
+	if err != nil {
+		// A single invalid policy fails the whole batch, so load each policy separately to return errors only to their callers
+		for _, request := range batch {
+			response, err := LoadPolicy(key.client, conjurapi.PolicyModePatch, request.policy, key.branch)
+			request.result <- batchResult{response: response, err: err}
+		}
+		return
+	}
+
+	for _, request := range batch {
+		request.result <- batchResult{response: response, err: nil}
+	}
+}
+
+// mergePolicies concatenates policies whose documents are lists of statements into a single list
+func mergePolicies(policies []string) string {
+	var sb strings.Builder
+	for _, policy := range policies {
+		policy = strings.TrimPrefix(strings.TrimSpace(policy), "---")
+		policy = strings.Trim(policy, "\n")
+		if policy == "" || policy == "[]" {
+			continue
+		}
+		sb.WriteString(policy)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,130 @@
+package policy
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// testBatchWindow leaves the test goroutines enough time to queue their policies into the same batch
+const testBatchWindow = 200 * time.Millisecond
+
+// policyMatching matches the loaded policy document, rewinding it so other expectations can match it too
+func policyMatching(match func(policy string) bool) interface{} {
+	return mock.MatchedBy(func(policy io.ReadSeeker) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		_, _ = policy.Seek(0, io.SeekStart)
+		return match(buf.String())
+	})
+}
+
+func policyContains(text string) interface{} {
+	return policyMatching(func(policy string) bool {
+		return strings.Contains(policy, text)
+	})
+}
+
+// loadConcurrently patches each policy into the branch from its own goroutine and returns the errors in order
+func loadConcurrently(b *batcher, client *mocks.MockClientV2, branch string, policies ...string) []error {
+	errs := make([]error, len(policies))
+	var wg sync.WaitGroup
+	for i, policy := range policies {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = b.load(client, policy, branch)
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+func TestBatcher_MergesConcurrentLoads(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", policyMatching(func(policy string) bool {
+		return strings.Count(policy, "- !group") == 3
+	})).Return(&conjurapi.PolicyResponse{Version: 2}, nil).Once()
+
+	errs := loadConcurrently(newBatcher(testBatchWindow), mockV2, "data",
+		"- !group admins\n", "- !group readers\n", "---\n- !group writers\n")
+
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	mockV2.AssertExpectations(t)
+}
+
+func TestBatcher_MergesSlashFormsOfBranch(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", policyMatching(func(policy string) bool {
+		return strings.Count(policy, "- !group") == 3
+	})).Return(&conjurapi.PolicyResponse{Version: 2}, nil).Once()
+
+	b := newBatcher(testBatchWindow)
+	var wg sync.WaitGroup
+	for branch, policy := range map[string]string{"data": "- !group admins\n", "/data": "- !group readers\n", "data/": "- !group writers\n"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := b.load(mockV2, policy, branch)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	mockV2.AssertExpectations(t)
+}
+
+func TestBatcher_SeparatesBranches(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", policyContains("!group admins")).Return(&conjurapi.PolicyResponse{}, nil).Once()
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/db", policyContains("!group readers")).Return(&conjurapi.PolicyResponse{}, nil).Once()
+
+	b := newBatcher(testBatchWindow)
+	var wg sync.WaitGroup
+	for branch, policy := range map[string]string{"data/apps": "- !group admins\n", "data/db": "- !group readers\n"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := b.load(mockV2, policy, branch)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	mockV2.AssertExpectations(t)
+}
+
+func TestBatcher_FailedBatchIsRetriedPerPolicy(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", policyMatching(func(policy string) bool {
+		return strings.Contains(policy, "!group admins") && strings.Contains(policy, "!invalid")
+	})).Return(nil, fmt.Errorf("422 Unprocessable Entity")).Once()
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", policyContains("- !invalid")).Return(nil, fmt.Errorf("422 Unprocessable Entity")).Once()
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", policyContains("- !group admins")).Return(&conjurapi.PolicyResponse{}, nil).Once()
+
+	errs := loadConcurrently(newBatcher(testBatchWindow), mockV2, "data", "- !group admins\n", "- !invalid record\n")
+
+	assert.NoError(t, errs[0])
+	assert.ErrorContains(t, errs[1], "422")
+	mockV2.AssertExpectations(t)
+}
+
+func TestMergePolicies(t *testing.T) {
+	merged := mergePolicies([]string{
+		"- !group admins\n",
+		"[]\n",
+		"---\n- !permit\n  role: !group admins\n  privileges: [read]\n  resource: !variable db-password\n",
+		"",
+	})
+
+	assert.Equal(t, "- !group admins\n"+
+		"- !permit\n  role: !group admins\n  privileges: [read]\n  resource: !variable db-password\n", merged)
+}
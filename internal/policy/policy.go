@@ -5,24 +5,56 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/cyberark/conjur-api-go/conjurapi"
 	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-// Create a global mutex to prevent concurrent policy updates
-var policyMutex sync.Mutex
+// branchLocks holds a mutex per policy branch, so loads into the same branch are serialized
+// while loads into different branches can run concurrently
+var branchLocks sync.Map
+
+// lockBranch locks the given policy branch and returns the function that unlocks it
+func lockBranch(branch string) func() {
+	lock, _ := branchLocks.LoadOrStore(strings.Trim(branch, "/"), &sync.Mutex{})
+	mu := lock.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
+
+// loadGeneration counts the policies loaded by this process, so cached reads can tell they may be stale
+var loadGeneration atomic.Uint64
+
+// Generation returns the number of policies loaded successfully by this process
+func Generation() uint64 {
+	return loadGeneration.Load()
+}
 
 // applyPolicy applies a policy to Conjur using PATCH mode
 func ApplyPolicy(client api.ClientV2, policy, branch string) error {
-	policyMutex.Lock()
-	defer policyMutex.Unlock()
+	_, err := PatchPolicy(client, policy, branch)
+	return err
+}
+
+// PatchPolicy applies a policy to Conjur using PATCH mode and returns the server response. Policies patched into
+// the same branch at about the same time are merged into a single load, so the response may include records
+// created by other callers.
+func PatchPolicy(client api.ClientV2, policy, branch string) (*conjurapi.PolicyResponse, error) {
+	return patchBatcher.load(client, policy, branch)
+}
 
-	policyResponse, err := client.LoadPolicy(conjurapi.PolicyModePatch, branch, strings.NewReader(policy))
+// LoadPolicy loads a policy to Conjur using the given mode and returns the server response
+func LoadPolicy(client api.ClientV2, mode conjurapi.PolicyMode, policy, branch string) (*conjurapi.PolicyResponse, error) {
+	unlock := lockBranch(branch)
+	defer unlock()
+
+	policyResponse, err := client.LoadPolicy(mode, branch, strings.NewReader(policy))
 	if err != nil {
-		return fmt.Errorf("failed to load policy: %w", err)
+		return nil, fmt.Errorf("failed to load policy: %w", err)
 	}
+	loadGeneration.Add(1)
 
 	// Log the policy response for debugging
 	tflog.Debug(context.Background(), "Policy applied successfully", map[string]interface{}{
@@ -30,5 +62,20 @@ func ApplyPolicy(client api.ClientV2, policy, branch string) error {
 		"version":       policyResponse.Version,
 	})
 
-	return nil
+	return policyResponse, nil
+}
+
+// DryRunPolicy validates a policy against Conjur using the given mode without applying it
+func DryRunPolicy(client api.ClientV2, mode conjurapi.PolicyMode, policy, branch string) (*conjurapi.DryRunPolicyResponse, error) {
+	dryRunResponse, err := client.DryRunPolicy(mode, branch, strings.NewReader(policy))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dry run policy: %w", err)
+	}
+
+	tflog.Debug(context.Background(), "Policy dry run completed", map[string]interface{}{
+		"status": dryRunResponse.Status,
+		"errors": len(dryRunResponse.Errors),
+	})
+
+	return dryRunResponse, nil
 }
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// certificateIssueRequestModel describes the certificate request attributes shared by the
+// conjur_certificate_issue data source and ephemeral resource and the conjur_certificate resource
+// and ephemeral resource.
+type certificateIssueRequestModel struct {
+	IssuerName   types.String   `tfsdk:"issuer_name"`
+	CommonName   types.String   `tfsdk:"common_name"`
+	Organization types.String   `tfsdk:"organization"`
+	OrgUnits     []types.String `tfsdk:"org_units"`
+	Locality     types.String   `tfsdk:"locality"`
+	State        types.String   `tfsdk:"state"`
+	Country      types.String   `tfsdk:"country"`
+	KeyType      types.String   `tfsdk:"key_type"`
+	TTL          types.String   `tfsdk:"ttl"`
+	Zone         types.String   `tfsdk:"zone"`
+
+	DNSNames       []types.String `tfsdk:"dns_names"`
+	IPAddresses    []types.String `tfsdk:"ip_addresses"`
+	EmailAddresses []types.String `tfsdk:"email_addresses"`
+	Uris           []types.String `tfsdk:"uris"`
+}
+
+// issue builds the API request for issuing a certificate
+func (m certificateIssueRequestModel) issue() conjurapi.Issue {
+	subject := conjurapi.IssuerSubject{
+		CommonName:   m.CommonName.ValueString(),
+		Organization: m.Organization.ValueString(),
+		Locality:     m.Locality.ValueString(),
+		State:        m.State.ValueString(),
+		Country:      m.Country.ValueString(),
+	}
+	for _, ou := range m.OrgUnits {
+		subject.OrgUnits = append(subject.OrgUnits, ou.ValueString())
+	}
+
+	alt := conjurapi.AltNames{}
+	for _, v := range m.DNSNames {
+		alt.DNSNames = append(alt.DNSNames, v.ValueString())
+	}
+	for _, v := range m.IPAddresses {
+		alt.IPAddresses = append(alt.IPAddresses, v.ValueString())
+	}
+	for _, v := range m.EmailAddresses {
+		alt.EMailAddresses = append(alt.EMailAddresses, v.ValueString())
+	}
+	for _, v := range m.Uris {
+		alt.Uris = append(alt.Uris, v.ValueString())
+	}
+
+	return conjurapi.Issue{
+		Subject:  subject,
+		KeyType:  m.KeyType.ValueString(),
+		AltNames: alt,
+		TTL:      m.TTL.ValueString(),
+		Zone:     m.Zone.ValueString(),
+	}
+}
+
+// certificateSignRequestModel describes the CSR signing attributes shared by the
+// conjur_certificate_sign data source and ephemeral resource.
+type certificateSignRequestModel struct {
+	IssuerName types.String `tfsdk:"issuer_name"`
+	Csr        types.String `tfsdk:"csr"`
+	Zone       types.String `tfsdk:"zone"`
+	TTL        types.String `tfsdk:"ttl"`
+}
+
+// sign builds the API request for signing a CSR
+func (m certificateSignRequestModel) sign() conjurapi.Sign {
+	return conjurapi.Sign{
+		Csr:  m.Csr.ValueString(),
+		Zone: m.Zone.ValueString(),
+		TTL:  m.TTL.ValueString(),
+	}
+}
+
+// certificateNotAfter returns the expiry of the first certificate in a PEM document
+func certificateNotAfter(certificatePEM string) (time.Time, error) {
+	block, _ := pem.Decode([]byte(certificatePEM))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, fmt.Errorf("no PEM encoded certificate found")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse certificate: %w", err)
+	}
+	return cert.NotAfter, nil
+}
+
+// certificateReadyForRenewal reports whether a certificate expiring at notAfter is within its early renewal window
+func certificateReadyForRenewal(notAfter time.Time, earlyRenewal time.Duration, now time.Time) bool {
+	return !now.Before(notAfter.Add(-earlyRenewal))
+}
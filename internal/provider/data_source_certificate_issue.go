@@ -140,38 +140,22 @@ func (d *certificateIssueDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	subject := conjurapi.IssuerSubject{
-		CommonName:   data.CommonName.ValueString(),
-		Organization: data.Organization.ValueString(),
-		Locality:     data.Locality.ValueString(),
-		State:        data.State.ValueString(),
-		Country:      data.Country.ValueString(),
-	}
-	for _, ou := range data.OrgUnits {
-		subject.OrgUnits = append(subject.OrgUnits, ou.ValueString())
-	}
-
-	alt := conjurapi.AltNames{}
-	for _, v := range data.DNSNames {
-		alt.DNSNames = append(alt.DNSNames, v.ValueString())
-	}
-	for _, v := range data.IPAddresses {
-		alt.IPAddresses = append(alt.IPAddresses, v.ValueString())
-	}
-	for _, v := range data.EmailAddresses {
-		alt.EMailAddresses = append(alt.EMailAddresses, v.ValueString())
-	}
-	for _, v := range data.Uris {
-		alt.Uris = append(alt.Uris, v.ValueString())
-	}
-
-	reqBody := conjurapi.Issue{
-		Subject:  subject,
-		KeyType:  data.KeyType.ValueString(),
-		AltNames: alt,
-		TTL:      data.TTL.ValueString(),
-		Zone:     data.Zone.ValueString(),
-	}
+	reqBody := certificateIssueRequestModel{
+		IssuerName:     data.IssuerName,
+		CommonName:     data.CommonName,
+		Organization:   data.Organization,
+		OrgUnits:       data.OrgUnits,
+		Locality:       data.Locality,
+		State:          data.State,
+		Country:        data.Country,
+		KeyType:        data.KeyType,
+		TTL:            data.TTL,
+		Zone:           data.Zone,
+		DNSNames:       data.DNSNames,
+		IPAddresses:    data.IPAddresses,
+		EmailAddresses: data.EmailAddresses,
+		Uris:           data.Uris,
+	}.issue()
 
 	tflog.Info(ctx, "Issuing certificate via Secrets Manager", map[string]interface{}{
 		"issuer_name": data.IssuerName.ValueString(),
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource              = &CertificateIssuerDataSource{}
+	_ datasource.DataSourceWithConfigure = &CertificateIssuerDataSource{}
+)
+
+// sensitiveIssuerDataKeys lists issuer data fields that are never exposed by the issuer data sources,
+// in addition to any value the API returns masked
+var sensitiveIssuerDataKeys = map[string]bool{
+	"secret_access_key": true,
+}
+
+func NewCertificateIssuerDataSource() datasource.DataSource {
+	return &CertificateIssuerDataSource{}
+}
+
+type CertificateIssuerDataSource struct {
+	client api.ClientV2
+}
+
+type CertificateIssuerDataSourceModel struct {
+	ID         types.String      `tfsdk:"id"`
+	Type       types.String      `tfsdk:"type"`
+	MaxTTL     types.Int64       `tfsdk:"max_ttl"`
+	Data       map[string]string `tfsdk:"data"`
+	CreatedAt  types.String      `tfsdk:"created_at"`
+	ModifiedAt types.String      `tfsdk:"modified_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *CertificateIssuerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_issuer"
+}
+
+func (d *CertificateIssuerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issuer from CyberArk Secrets Manager. Sensitive issuer data fields are not returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the issuer",
+			},
+			"type": schema.StringAttribute{
+				Computed:    true,
+				Description: "type of the issuer",
+			},
+			"max_ttl": schema.Int64Attribute{
+				Computed:    true,
+				Description: "maximum TTL, in seconds, of the certificates or secrets created with the issuer",
+			},
+			"data": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "non-sensitive issuer data fields",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "creation time of the issuer",
+			},
+			"modified_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "last modification time of the issuer",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *CertificateIssuerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *CertificateIssuerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data CertificateIssuerDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "reading issuer", map[string]interface{}{"id": data.ID.ValueString()})
+
+	issuer, err := d.client.Issuer(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read issuer", fmt.Sprintf("Unable to read issuer %q: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	data = issuerDataSourceModel(issuer)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// issuerDataSourceModel maps an issuer to the data source model, dropping sensitive data fields
+func issuerDataSourceModel(issuer conjurapi.Issuer) CertificateIssuerDataSourceModel {
+	return CertificateIssuerDataSourceModel{
+		ID:         types.StringValue(issuer.ID),
+		Type:       types.StringValue(issuer.Type),
+		MaxTTL:     types.Int64Value(int64(issuer.MaxTTL)),
+		Data:       publicIssuerData(issuer.Data),
+		CreatedAt:  types.StringValue(issuer.CreatedAt),
+		ModifiedAt: types.StringValue(issuer.ModifiedAt),
+	}
+}
+
+// publicIssuerData returns the issuer data fields that are safe to expose
+func publicIssuerData(data map[string]interface{}) map[string]string {
+	result := make(map[string]string, len(data))
+	for k, v := range data {
+		if sensitiveIssuerDataKeys[k] {
+			continue
+		}
+		value := issuerDataString(v)
+		if value == maskedIssuerSecret {
+			continue
+		}
+		result[k] = value
+	}
+	return result
+}
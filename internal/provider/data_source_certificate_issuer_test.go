@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateIssuerDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewCertificateIssuerDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestPublicIssuerData(t *testing.T) {
+	data := publicIssuerData(map[string]interface{}{
+		"access_key_id":     "AKIAEXAMPLE",
+		"secret_access_key": "not-masked-but-still-secret",
+		"api_key":           maskedIssuerSecret,
+		"retries":           float64(3),
+	})
+
+	assert.Equal(t, map[string]string{
+		"access_key_id": "AKIAEXAMPLE",
+		"retries":       "3",
+	}, data)
+}
+
+func TestCertificateIssuersDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewCertificateIssuersDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateIssuerDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful issuer retrieval",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Issuer", "aws-issuer").Return(conjurapi.Issuer{
+					ID:         "aws-issuer",
+					Type:       "aws",
+					MaxTTL:     3600,
+					Data:       map[string]interface{}{"access_key_id": "AKIAEXAMPLE", "secret_access_key": maskedIssuerSecret},
+					CreatedAt:  "2026-01-01T00:00:00Z",
+					ModifiedAt: "2026-01-02T00:00:00Z",
+				}, nil)
+			},
+		},
+		{
+			name: "issuer not found",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Issuer", "aws-issuer").Return(conjurapi.Issuer{}, fmt.Errorf("404 Not Found"))
+			},
+			expectedError: true,
+			errorContains: "Failed to read issuer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &CertificateIssuerDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getCertificateIssuerDataSourceTestSchema()
+			config := CertificateIssuerDataSourceModel{
+				ID:         types.StringValue("aws-issuer"),
+				Type:       types.StringNull(),
+				MaxTTL:     types.Int64Null(),
+				CreatedAt:  types.StringNull(),
+				ModifiedAt: types.StringNull(),
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result CertificateIssuerDataSourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, "aws", result.Type.ValueString())
+				assert.Equal(t, int64(3600), result.MaxTTL.ValueInt64())
+				assert.Equal(t, map[string]string{"access_key_id": "AKIAEXAMPLE"}, result.Data)
+				assert.Equal(t, "2026-01-02T00:00:00Z", result.ModifiedAt.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCertificateIssuersDataSource_Read(t *testing.T) {
+	issuers := []conjurapi.Issuer{
+		{ID: "zeta", Type: "aws", MaxTTL: 900, Data: map[string]interface{}{"access_key_id": "AKIAZ"}},
+		{ID: "alpha", Type: "aws", MaxTTL: 3600, Data: map[string]interface{}{"access_key_id": "AKIAA"}},
+		{ID: "other", Type: "venafi", MaxTTL: 7200},
+	}
+
+	tests := []struct {
+		name        string
+		typeFilter  types.String
+		expectedIDs []string
+	}{
+		{
+			name:        "all issuers sorted by ID",
+			typeFilter:  types.StringNull(),
+			expectedIDs: []string{"alpha", "other", "zeta"},
+		},
+		{
+			name:        "filtered by type",
+			typeFilter:  types.StringValue("aws"),
+			expectedIDs: []string{"alpha", "zeta"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			mockV2.On("Issuers").Return(issuers, nil)
+
+			d := &CertificateIssuersDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getCertificateIssuersDataSourceTestSchema()
+			config := CertificateIssuersDataSourceModel{Type: tt.typeFilter}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			var result CertificateIssuersDataSourceModel
+			resp.State.Get(ctx, &result)
+			var ids []string
+			for _, issuer := range result.Issuers {
+				ids = append(ids, issuer.ID.ValueString())
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCertificateIssuersDataSource_Read_Error(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("Issuers").Return(nil, fmt.Errorf("403 Forbidden"))
+
+	d := &CertificateIssuersDataSource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	testSchema := getCertificateIssuersDataSourceTestSchema()
+	config := CertificateIssuersDataSourceModel{Type: types.StringNull()}
+
+	req := datasource.ReadRequest{
+		Config: tfsdk.Config{
+			Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+			Schema: testSchema,
+		},
+	}
+	resp := &datasource.ReadResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+	}
+
+	d.Read(ctx, req, resp)
+
+	assert.True(t, diagnosticsContain(resp.Diagnostics, "Failed to list issuers"))
+	mockV2.AssertExpectations(t)
+}
+
+func getCertificateIssuerDataSourceTestSchema() schema.Schema {
+	d := &CertificateIssuerDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
+
+func getCertificateIssuersDataSourceTestSchema() schema.Schema {
+	d := &CertificateIssuersDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &CertificateIssuersDataSource{}
+	_ datasource.DataSourceWithConfigure = &CertificateIssuersDataSource{}
+)
+
+func NewCertificateIssuersDataSource() datasource.DataSource {
+	return &CertificateIssuersDataSource{}
+}
+
+type CertificateIssuersDataSource struct {
+	client api.ClientV2
+}
+
+type CertificateIssuersDataSourceModel struct {
+	Type    types.String                       `tfsdk:"type"`
+	Issuers []CertificateIssuerDataSourceModel `tfsdk:"issuers"`
+}
+
+// Metadata returns the data source type name.
+func (d *CertificateIssuersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_issuers"
+}
+
+func (d *CertificateIssuersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Issuers from CyberArk Secrets Manager that the provider identity is permitted to view. Sensitive issuer data fields are not returned.",
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Description: "only return issuers of this type",
+			},
+			"issuers": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "issuers, sorted by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the issuer",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "type of the issuer",
+						},
+						"max_ttl": schema.Int64Attribute{
+							Computed:    true,
+							Description: "maximum TTL, in seconds, of the certificates or secrets created with the issuer",
+						},
+						"data": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "non-sensitive issuer data fields",
+						},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "creation time of the issuer",
+						},
+						"modified_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "last modification time of the issuer",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *CertificateIssuersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *CertificateIssuersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data CertificateIssuersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issuers, err := d.client.Issuers()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list issuers", fmt.Sprintf("Unable to list issuers: %s", err))
+		return
+	}
+
+	data.Issuers = make([]CertificateIssuerDataSourceModel, 0, len(issuers))
+	for _, issuer := range issuers {
+		if !data.Type.IsNull() && issuer.Type != data.Type.ValueString() {
+			continue
+		}
+		data.Issuers = append(data.Issuers, issuerDataSourceModel(issuer))
+	}
+	sort.Slice(data.Issuers, func(i, j int) bool {
+		return data.Issuers[i].ID.ValueString() < data.Issuers[j].ID.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -112,11 +112,12 @@ func (d *certificateSignDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	signReq := conjurapi.Sign{
-		Csr:  data.Csr.ValueString(),
-		Zone: data.Zone.ValueString(),
-		TTL:  data.TTL.ValueString(),
-	}
+	signReq := certificateSignRequestModel{
+		IssuerName: data.IssuerName,
+		Csr:        data.Csr,
+		Zone:       data.Zone,
+		TTL:        data.TTL,
+	}.sign()
 
 	tflog.Info(ctx, "Signing CSR via Secrets Manager issuer", map[string]interface{}{
 		"issuer_name": data.IssuerName.ValueString(),
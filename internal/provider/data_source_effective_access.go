@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &EffectiveAccessDataSource{}
+	_ datasource.DataSourceWithConfigure = &EffectiveAccessDataSource{}
+)
+
+func NewEffectiveAccessDataSource() datasource.DataSource {
+	return &EffectiveAccessDataSource{}
+}
+
+type EffectiveAccessDataSource struct {
+	client api.ClientV2
+}
+
+type EffectiveAccessDataSourceModel struct {
+	Role       types.String          `tfsdk:"role"`
+	Kind       types.String          `tfsdk:"kind"`
+	Privileges types.List            `tfsdk:"privileges"`
+	Access     []resourceAccessModel `tfsdk:"access"`
+}
+
+// resourceAccessModel describes the privileges a role holds on a resource
+type resourceAccessModel struct {
+	Resource   types.String `tfsdk:"resource"`
+	Kind       types.String `tfsdk:"kind"`
+	Name       types.String `tfsdk:"name"`
+	Privileges []string     `tfsdk:"privileges"`
+}
+
+// Metadata returns the data source type name.
+func (d *EffectiveAccessDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_access"
+}
+
+func (d *EffectiveAccessDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resources in CyberArk Secrets Manager that a role can access, with the privileges it holds on each, whether permitted directly or inherited. " +
+			"Every privilege is checked separately, so reading many resources makes many requests.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "role to report on, as `kind:id` (e.g. `host:data/apps/app-1`) or fully qualified ID",
+			},
+			"kind": schema.StringAttribute{
+				Optional:    true,
+				Description: "only report resources of this kind, e.g. `variable`",
+			},
+			"privileges": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "privileges to check (default: the standard privileges of each resource kind)",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"access": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "resources on which the role holds at least one of the checked privileges, in the order returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource": schema.StringAttribute{
+							Computed:    true,
+							Description: "fully qualified ID of the resource",
+						},
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "kind of the resource",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the resource without account and kind, including its policy branch",
+						},
+						"privileges": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "checked privileges the role holds on the resource, in the order they were checked",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *EffectiveAccessDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *EffectiveAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data EffectiveAccessDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The role filter limits the listing to the resources visible to the role, which are the only ones it can hold privileges on.
+	// It is sent as is, so it has to be fully qualified.
+	roleID := qualifyRoleID(d.client, data.Role.ValueString())
+	resources, err := listResources(d.client, conjurapi.ResourceFilter{Kind: data.Kind.ValueString(), Role: roleID}, 0)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read effective access", fmt.Sprintf("Unable to list resources visible to role %q: %s", roleID, err))
+		return
+	}
+
+	data.Access = make([]resourceAccessModel, 0, len(resources))
+	for _, conjurResource := range resources {
+		resourceID, _ := conjurResource["id"].(string)
+		kind, name, err := splitFullyQualifiedID(resourceID)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read effective access", err.Error())
+			return
+		}
+
+		privileges := resourcePrivileges(kind)
+		if !data.Privileges.IsNull() {
+			privileges = normalizePrivileges(data.Privileges)
+		}
+		held, err := heldPrivileges(d.client, resourceID, roleID, privileges)
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to read effective access", fmt.Sprintf("Unable to check privileges of role %q on %q: %s", roleID, resourceID, err))
+			return
+		}
+		if len(held) == 0 {
+			continue
+		}
+
+		data.Access = append(data.Access, resourceAccessModel{
+			Resource:   types.StringValue(resourceID),
+			Kind:       types.StringValue(kind),
+			Name:       types.StringValue(name),
+			Privileges: held,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// heldPrivileges checks each privilege of a role on a resource, returning those the role holds directly or through inheritance
+func heldPrivileges(client api.ClientV2, resourceID, roleID string, privileges []string) ([]string, error) {
+	held := []string{}
+	for _, privilege := range privileges {
+		allowed, err := client.CheckPermissionForRole(resourceID, roleID, privilege)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			held = append(held, privilege)
+		}
+	}
+	return held, nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveAccessDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewEffectiveAccessDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestHeldPrivileges(t *testing.T) {
+	t.Run("Returns the held privileges in order", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("CheckPermissionForRole", "myaccount:variable:data/db-password", "host:data/app-1", "read").Return(true, nil).Once()
+		mockV2.On("CheckPermissionForRole", "myaccount:variable:data/db-password", "host:data/app-1", "update").Return(false, nil).Once()
+		mockV2.On("CheckPermissionForRole", "myaccount:variable:data/db-password", "host:data/app-1", "execute").Return(true, nil).Once()
+
+		held, err := heldPrivileges(mockV2, "myaccount:variable:data/db-password", "host:data/app-1", []string{"read", "update", "execute"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"read", "execute"}, held)
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Stops at the first failed check", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("CheckPermissionForRole", "myaccount:variable:data/db-password", "host:data/app-1", "read").Return(false, fmt.Errorf("403 Forbidden")).Once()
+
+		_, err := heldPrivileges(mockV2, "myaccount:variable:data/db-password", "host:data/app-1", []string{"read", "execute"})
+
+		assert.ErrorContains(t, err, "403")
+		mockV2.AssertExpectations(t)
+	})
+}
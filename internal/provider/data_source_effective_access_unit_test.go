@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveAccessDataSource_Read(t *testing.T) {
+	const role = "host:data/apps/app-1"
+	const qualifiedRole = "myaccount:host:data/apps/app-1"
+	const dbPassword = "myaccount:variable:data/apps/db-password"
+	const authenticator = "myaccount:webservice:conjur/authn-jwt/github"
+
+	tests := []struct {
+		name           string
+		privileges     []string
+		setupMock      func(*mocks.MockClientV2)
+		expectedAccess []resourceAccessModel
+		expectedError  bool
+		errorContains  string
+	}{
+		{
+			name: "standard privileges of each kind",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Role: qualifiedRole}).Return(&conjurapi.ResourcesCount{Count: 2}, nil)
+				mockV2.On("Resources", &conjurapi.ResourceFilter{Role: qualifiedRole, Limit: 2}).Return([]map[string]interface{}{
+					{"id": dbPassword},
+					{"id": authenticator},
+				}, nil)
+				for _, privilege := range []string{"read", "execute"} {
+					mockV2.On("CheckPermissionForRole", dbPassword, qualifiedRole, privilege).Return(true, nil).Once()
+				}
+				for _, privilege := range []string{"update", "create"} {
+					mockV2.On("CheckPermissionForRole", dbPassword, qualifiedRole, privilege).Return(false, nil).Once()
+				}
+				mockV2.On("CheckPermissionForRole", authenticator, qualifiedRole, "authenticate").Return(true, nil).Once()
+				mockV2.On("CheckPermissionForRole", authenticator, qualifiedRole, mock.Anything).Return(false, nil).Twice()
+			},
+			expectedAccess: []resourceAccessModel{
+				{Resource: types.StringValue(dbPassword), Kind: types.StringValue("variable"), Name: types.StringValue("data/apps/db-password"), Privileges: []string{"read", "execute"}},
+				{Resource: types.StringValue(authenticator), Kind: types.StringValue("webservice"), Name: types.StringValue("conjur/authn-jwt/github"), Privileges: []string{"authenticate"}},
+			},
+		},
+		{
+			name:       "resources without the checked privileges are omitted",
+			privileges: []string{"Update"},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: 1}, nil)
+				mockV2.On("Resources", mock.Anything).Return([]map[string]interface{}{{"id": dbPassword}}, nil)
+				mockV2.On("CheckPermissionForRole", dbPassword, qualifiedRole, "update").Return(false, nil).Once()
+			},
+			expectedAccess: []resourceAccessModel{},
+		},
+		{
+			name: "API error checking a privilege",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: 1}, nil)
+				mockV2.On("Resources", mock.Anything).Return([]map[string]interface{}{{"id": dbPassword}}, nil)
+				mockV2.On("CheckPermissionForRole", dbPassword, qualifiedRole, "read").Return(false, fmt.Errorf("500 Internal Server Error")).Once()
+			},
+			expectedError: true,
+			errorContains: "Unable to check privileges",
+		},
+		{
+			name: "API error listing resources",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", mock.Anything).Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Failed to read effective access",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			mockV2.On("GetConfig").Return(conjurapi.Config{Account: "myaccount"})
+			tt.setupMock(mockV2)
+
+			d := &EffectiveAccessDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getEffectiveAccessDataSourceTestSchema()
+			config := EffectiveAccessDataSourceModel{
+				Role:       types.StringValue(role),
+				Kind:       types.StringNull(),
+				Privileges: types.ListNull(types.StringType),
+			}
+			if tt.privileges != nil {
+				config.Privileges, _ = types.ListValueFrom(ctx, types.StringType, tt.privileges)
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result EffectiveAccessDataSourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, tt.expectedAccess, result.Access)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getEffectiveAccessDataSourceTestSchema() schema.Schema {
+	d := &EffectiveAccessDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
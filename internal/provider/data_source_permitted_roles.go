@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &PermittedRolesDataSource{}
+	_ datasource.DataSourceWithConfigure = &PermittedRolesDataSource{}
+)
+
+func NewPermittedRolesDataSource() datasource.DataSource {
+	return &PermittedRolesDataSource{}
+}
+
+type PermittedRolesDataSource struct {
+	client api.ClientV2
+}
+
+type PermittedRolesDataSourceModel struct {
+	Resource  types.String         `tfsdk:"resource"`
+	Privilege types.String         `tfsdk:"privilege"`
+	Roles     []permittedRoleModel `tfsdk:"roles"`
+}
+
+// permittedRoleModel describes a role holding a privilege on a resource
+type permittedRoleModel struct {
+	ID     types.String `tfsdk:"id"`
+	Kind   types.String `tfsdk:"kind"`
+	Branch types.String `tfsdk:"branch"`
+	Name   types.String `tfsdk:"name"`
+}
+
+// Metadata returns the data source type name.
+func (d *PermittedRolesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permitted_roles"
+}
+
+func (d *PermittedRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Roles in CyberArk Secrets Manager holding a privilege on a resource, whether permitted directly or inherited through role memberships and ownership.",
+		Attributes: map[string]schema.Attribute{
+			"resource": schema.StringAttribute{
+				Required:    true,
+				Description: "resource to check, as `kind:id` (e.g. `variable:data/apps/db-password`) or fully qualified ID",
+			},
+			"privilege": schema.StringAttribute{
+				Required:    true,
+				Description: "privilege to check, e.g. `execute` to find the roles that can fetch a secret",
+			},
+			"roles": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "roles holding the privilege, sorted by ID",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "fully qualified ID of the role",
+						},
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "kind of the role",
+						},
+						"branch": schema.StringAttribute{
+							Computed:    true,
+							Description: "policy branch of the role",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "name of the role within its branch",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *PermittedRolesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *PermittedRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data PermittedRolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleIDs, err := d.client.PermittedRoles(data.Resource.ValueString(), data.Privilege.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read permitted roles", fmt.Sprintf("Unable to read roles permitted to %s %q: %s", data.Privilege.ValueString(), data.Resource.ValueString(), err))
+		return
+	}
+
+	roles, err := permittedRoles(roleIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read permitted roles", err.Error())
+		return
+	}
+	data.Roles = roles
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// permittedRoles splits the fully qualified IDs returned by the permitted roles API into kind, branch and name, sorted by ID
+func permittedRoles(roleIDs []string) ([]permittedRoleModel, error) {
+	sorted := append([]string(nil), roleIDs...)
+	sort.Strings(sorted)
+
+	roles := make([]permittedRoleModel, 0, len(sorted))
+	for _, fqID := range sorted {
+		kind, id, err := splitFullyQualifiedID(fqID)
+		if err != nil {
+			return nil, err
+		}
+		_, branch, name, err := splitConjurID(kind + "/" + id)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, permittedRoleModel{
+			ID:     types.StringValue(fqID),
+			Kind:   types.StringValue(kind),
+			Branch: types.StringValue(branch),
+			Name:   types.StringValue(name),
+		})
+	}
+	return roles, nil
+}
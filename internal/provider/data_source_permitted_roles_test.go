@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermittedRolesDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewPermittedRolesDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestPermittedRoles(t *testing.T) {
+	t.Run("Splits and sorts role IDs", func(t *testing.T) {
+		roles, err := permittedRoles([]string{
+			"myaccount:host:data/apps/payments/app-1",
+			"myaccount:user:admin",
+			"myaccount:policy:data",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []permittedRoleModel{
+			{
+				ID:     types.StringValue("myaccount:host:data/apps/payments/app-1"),
+				Kind:   types.StringValue("host"),
+				Branch: types.StringValue("data/apps/payments"),
+				Name:   types.StringValue("app-1"),
+			},
+			{
+				ID:     types.StringValue("myaccount:policy:data"),
+				Kind:   types.StringValue("policy"),
+				Branch: types.StringValue(""),
+				Name:   types.StringValue("data"),
+			},
+			{
+				ID:     types.StringValue("myaccount:user:admin"),
+				Kind:   types.StringValue("user"),
+				Branch: types.StringValue(""),
+				Name:   types.StringValue("admin"),
+			},
+		}, roles)
+	})
+
+	t.Run("Invalid role ID", func(t *testing.T) {
+		_, err := permittedRoles([]string{"admin"})
+		assert.ErrorContains(t, err, "invalid fully qualified Secrets Manager ID")
+	})
+}
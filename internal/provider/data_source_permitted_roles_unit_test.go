@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermittedRolesDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedIDs   []string
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "direct and inherited roles",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("PermittedRoles", "variable:data/apps/db-password", "execute").Return([]string{
+					"myaccount:policy:data/apps",
+					"myaccount:group:data/payments-team",
+					"myaccount:host:data/apps/app-1",
+				}, nil)
+			},
+			expectedIDs: []string{"myaccount:group:data/payments-team", "myaccount:host:data/apps/app-1", "myaccount:policy:data/apps"},
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("PermittedRoles", "variable:data/apps/db-password", "execute").Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Failed to read permitted roles",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &PermittedRolesDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getPermittedRolesDataSourceTestSchema()
+			config := PermittedRolesDataSourceModel{
+				Resource:  types.StringValue("variable:data/apps/db-password"),
+				Privilege: types.StringValue("execute"),
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result PermittedRolesDataSourceModel
+				resp.State.Get(ctx, &result)
+				ids := []string{}
+				for _, r := range result.Roles {
+					ids = append(ids, r.ID.ValueString())
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+				assert.Equal(t, "data/apps", result.Roles[1].Branch.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getPermittedRolesDataSourceTestSchema() schema.Schema {
+	d := &PermittedRolesDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ResourceDataSource{}
+	_ datasource.DataSourceWithConfigure = &ResourceDataSource{}
+)
+
+func NewResourceDataSource() datasource.DataSource {
+	return &ResourceDataSource{}
+}
+
+type ResourceDataSource struct {
+	client api.ClientV2
+}
+
+type ResourceDataSourceModel struct {
+	ID             types.String              `tfsdk:"id"`
+	FullID         types.String              `tfsdk:"full_id"`
+	Kind           types.String              `tfsdk:"kind"`
+	Name           types.String              `tfsdk:"name"`
+	Owner          types.String              `tfsdk:"owner"`
+	Policy         types.String              `tfsdk:"policy"`
+	Annotations    map[string]string         `tfsdk:"annotations"`
+	CreatedAt      types.String              `tfsdk:"created_at"`
+	Permissions    []resourcePermissionModel `tfsdk:"permissions"`
+	SecretVersions []int64                   `tfsdk:"secret_versions"`
+}
+
+// resourcePermissionModel describes a privilege permitted to a role on a resource
+type resourcePermissionModel struct {
+	Privilege types.String `tfsdk:"privilege"`
+	Role      types.String `tfsdk:"role"`
+	Policy    types.String `tfsdk:"policy"`
+}
+
+// Metadata returns the data source type name.
+func (d *ResourceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resource"
+}
+
+func (d *ResourceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := conjurResourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Required:    true,
+		Description: "ID of the resource, as `kind:name` (e.g. `variable:data/apps/db-password`) or fully qualified ID",
+	}
+	attributes["full_id"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "fully qualified ID of the resource, as `account:kind:name`",
+	}
+	attributes["permissions"] = schema.ListNestedAttribute{
+		Computed:    true,
+		Description: "privileges permitted on the resource, sorted by role and privilege",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"privilege": schema.StringAttribute{
+					Computed:    true,
+					Description: "permitted privilege",
+				},
+				"role": schema.StringAttribute{
+					Computed:    true,
+					Description: "fully qualified ID of the role the privilege is permitted to",
+				},
+				"policy": schema.StringAttribute{
+					Computed:    true,
+					Description: "fully qualified ID of the policy that permitted the privilege",
+				},
+			},
+		},
+	}
+	attributes["secret_versions"] = schema.ListAttribute{
+		Computed:    true,
+		ElementType: types.Int64Type,
+		Description: "versions of the secret values stored in a variable, oldest first; null for other kinds",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Resource from CyberArk Secrets Manager with its owner, annotations and permissions. Secret values are not returned.",
+		Attributes:  attributes,
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *ResourceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *ResourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data ResourceDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conjurResource, err := d.client.Resource(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read resource", fmt.Sprintf("Unable to read resource %q: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	resource := conjurResourceModelFrom(conjurResource)
+	data.FullID = resource.ID
+	data.Kind = resource.Kind
+	data.Name = resource.Name
+	data.Owner = resource.Owner
+	data.Policy = resource.Policy
+	data.Annotations = resource.Annotations
+	data.CreatedAt = resource.CreatedAt
+	data.Permissions = resourcePermissions(conjurResource)
+	data.SecretVersions = secretVersions(conjurResource)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// resourcePermissions returns the permissions of a resource returned by the API, sorted by role and privilege
+func resourcePermissions(conjurResource map[string]interface{}) []resourcePermissionModel {
+	rawPermissions, _ := conjurResource["permissions"].([]interface{})
+	permissions := make([]resourcePermissionModel, 0, len(rawPermissions))
+	for _, p := range rawPermissions {
+		permission, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		privilege, _ := permission["privilege"].(string)
+		role, _ := permission["role"].(string)
+		policy := types.StringNull()
+		if value, ok := permission["policy"].(string); ok && value != "" {
+			policy = types.StringValue(value)
+		}
+		permissions = append(permissions, resourcePermissionModel{
+			Privilege: types.StringValue(privilege),
+			Role:      types.StringValue(role),
+			Policy:    policy,
+		})
+	}
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Role.ValueString() != permissions[j].Role.ValueString() {
+			return permissions[i].Role.ValueString() < permissions[j].Role.ValueString()
+		}
+		return permissions[i].Privilege.ValueString() < permissions[j].Privilege.ValueString()
+	})
+	return permissions
+}
+
+// secretVersions returns the secret versions of a variable returned by the API, or nil for other resources
+func secretVersions(conjurResource map[string]interface{}) []int64 {
+	rawSecrets, ok := conjurResource["secrets"].([]interface{})
+	if !ok {
+		return nil
+	}
+	versions := make([]int64, 0, len(rawSecrets))
+	for _, s := range rawSecrets {
+		secret, _ := s.(map[string]interface{})
+		if version, ok := secret["version"].(float64); ok {
+			versions = append(versions, int64(version))
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+	return versions
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewResourceDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestResourcePermissions(t *testing.T) {
+	permissions := resourcePermissions(map[string]interface{}{
+		"permissions": []interface{}{
+			map[string]interface{}{"privilege": "read", "role": "myaccount:host:data/apps/app-1", "policy": "myaccount:policy:data/apps"},
+			map[string]interface{}{"privilege": "execute", "role": "myaccount:group:data/payments-team", "policy": "myaccount:policy:data"},
+			map[string]interface{}{"privilege": "read", "role": "myaccount:group:data/payments-team"},
+		},
+	})
+
+	assert.Equal(t, []resourcePermissionModel{
+		{Privilege: types.StringValue("execute"), Role: types.StringValue("myaccount:group:data/payments-team"), Policy: types.StringValue("myaccount:policy:data")},
+		{Privilege: types.StringValue("read"), Role: types.StringValue("myaccount:group:data/payments-team"), Policy: types.StringNull()},
+		{Privilege: types.StringValue("read"), Role: types.StringValue("myaccount:host:data/apps/app-1"), Policy: types.StringValue("myaccount:policy:data/apps")},
+	}, permissions)
+
+	assert.Empty(t, resourcePermissions(map[string]interface{}{}))
+}
+
+func TestSecretVersions(t *testing.T) {
+	t.Run("Variable", func(t *testing.T) {
+		versions := secretVersions(map[string]interface{}{
+			"secrets": []interface{}{
+				map[string]interface{}{"version": float64(2), "expires_at": nil},
+				map[string]interface{}{"version": float64(1)},
+			},
+		})
+		assert.Equal(t, []int64{1, 2}, versions)
+	})
+
+	t.Run("Variable without a value", func(t *testing.T) {
+		assert.Equal(t, []int64{}, secretVersions(map[string]interface{}{"secrets": []interface{}{}}))
+	})
+
+	t.Run("Other kinds", func(t *testing.T) {
+		assert.Nil(t, secretVersions(map[string]interface{}{"id": "myaccount:host:data/apps/app-1"}))
+	})
+}
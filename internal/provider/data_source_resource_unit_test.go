@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		checkResult   func(*testing.T, ResourceDataSourceModel)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "variable",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/apps/db-password").Return(map[string]interface{}{
+					"id":         "myaccount:variable:data/apps/db-password",
+					"owner":      "myaccount:policy:data/apps",
+					"policy":     "myaccount:policy:data/apps",
+					"created_at": "2026-01-12T09:30:00.000+00:00",
+					"annotations": []interface{}{
+						map[string]interface{}{"name": "team", "value": "payments", "policy": "myaccount:policy:data/apps"},
+					},
+					"permissions": []interface{}{
+						map[string]interface{}{"privilege": "read", "role": "myaccount:host:data/apps/app-1", "policy": "myaccount:policy:data/apps"},
+					},
+					"secrets": []interface{}{
+						map[string]interface{}{"version": float64(1)},
+						map[string]interface{}{"version": float64(2)},
+					},
+				}, nil)
+			},
+			checkResult: func(t *testing.T, result ResourceDataSourceModel) {
+				assert.Equal(t, "myaccount:variable:data/apps/db-password", result.FullID.ValueString())
+				assert.Equal(t, "variable", result.Kind.ValueString())
+				assert.Equal(t, "data/apps/db-password", result.Name.ValueString())
+				assert.Equal(t, "myaccount:policy:data/apps", result.Owner.ValueString())
+				assert.Equal(t, map[string]string{"team": "payments"}, result.Annotations)
+				require.Len(t, result.Permissions, 1)
+				assert.Equal(t, "myaccount:host:data/apps/app-1", result.Permissions[0].Role.ValueString())
+				assert.Equal(t, []int64{1, 2}, result.SecretVersions)
+			},
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/apps/db-password").Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectedError: true,
+			errorContains: "Failed to read resource",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &ResourceDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getResourceDataSourceTestSchema()
+			config := ResourceDataSourceModel{
+				ID:        types.StringValue("variable:data/apps/db-password"),
+				FullID:    types.StringNull(),
+				Kind:      types.StringNull(),
+				Name:      types.StringNull(),
+				Owner:     types.StringNull(),
+				Policy:    types.StringNull(),
+				CreatedAt: types.StringNull(),
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result ResourceDataSourceModel
+				resp.State.Get(ctx, &result)
+				tt.checkResult(t, result)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getResourceDataSourceTestSchema() schema.Schema {
+	d := &ResourceDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
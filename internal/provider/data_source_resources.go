@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &ResourcesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ResourcesDataSource{}
+)
+
+func NewResourcesDataSource() datasource.DataSource {
+	return &ResourcesDataSource{}
+}
+
+type ResourcesDataSource struct {
+	client api.ClientV2
+}
+
+type ResourcesDataSourceModel struct {
+	Kind      types.String          `tfsdk:"kind"`
+	Search    types.String          `tfsdk:"search"`
+	Role      types.String          `tfsdk:"role"`
+	Limit     types.Int64           `tfsdk:"limit"`
+	Offset    types.Int64           `tfsdk:"offset"`
+	Resources []conjurResourceModel `tfsdk:"resources"`
+}
+
+// conjurResourceModel describes a resource returned by the resources API
+type conjurResourceModel struct {
+	ID          types.String      `tfsdk:"id"`
+	Kind        types.String      `tfsdk:"kind"`
+	Name        types.String      `tfsdk:"name"`
+	Owner       types.String      `tfsdk:"owner"`
+	Policy      types.String      `tfsdk:"policy"`
+	Annotations map[string]string `tfsdk:"annotations"`
+	CreatedAt   types.String      `tfsdk:"created_at"`
+}
+
+// conjurResourceAttributes returns the computed attributes describing a resource
+func conjurResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "fully qualified ID of the resource, as `account:kind:name`",
+		},
+		"kind": schema.StringAttribute{
+			Computed:    true,
+			Description: "kind of the resource",
+		},
+		"name": schema.StringAttribute{
+			Computed:    true,
+			Description: "ID of the resource without account and kind, including its policy branch",
+		},
+		"owner": schema.StringAttribute{
+			Computed:    true,
+			Description: "fully qualified ID of the role owning the resource",
+		},
+		"policy": schema.StringAttribute{
+			Computed:    true,
+			Description: "fully qualified ID of the policy that declared the resource",
+		},
+		"annotations": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "annotations of the resource",
+		},
+		"created_at": schema.StringAttribute{
+			Computed:    true,
+			Description: "creation time of the resource",
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *ResourcesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_resources"
+}
+
+func (d *ResourcesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Resources from CyberArk Secrets Manager that the provider identity is permitted to view, optionally filtered by kind, search text and role. " +
+			"Every matching resource is returned, however many pages the server splits them into.",
+		Attributes: map[string]schema.Attribute{
+			"kind": schema.StringAttribute{
+				Optional:    true,
+				Description: "only return resources of this kind, e.g. `variable` or `host`",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "only return resources whose ID or annotations match this text",
+			},
+			"role": schema.StringAttribute{
+				Optional:    true,
+				Description: "only return resources visible to this role, as `kind:id` (e.g. `host:data/apps/app-1`) or fully qualified ID",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Description: "maximum number of resources to return (default: all)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"offset": schema.Int64Attribute{
+				Optional:    true,
+				Description: "number of matching resources to skip (default: 0)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"resources": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "matching resources, in the order returned by the server",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: conjurResourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *ResourcesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *ResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data ResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := conjurapi.ResourceFilter{
+		Kind:   data.Kind.ValueString(),
+		Search: data.Search.ValueString(),
+		Offset: int(data.Offset.ValueInt64()),
+	}
+	// The role is sent as is, so it has to be fully qualified
+	if role := data.Role.ValueString(); role != "" {
+		filter.Role = qualifyRoleID(d.client, role)
+	}
+	resources, err := listResources(d.client, filter, int(data.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to list resources", fmt.Sprintf("Unable to list resources: %s", err))
+		return
+	}
+
+	data.Resources = make([]conjurResourceModel, 0, len(resources))
+	for _, conjurResource := range resources {
+		data.Resources = append(data.Resources, conjurResourceModelFrom(conjurResource))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// conjurResourceModelFrom converts a resource returned by the API into its data source model
+func conjurResourceModelFrom(conjurResource map[string]interface{}) conjurResourceModel {
+	field := func(name string) types.String {
+		if value, ok := conjurResource[name].(string); ok && value != "" {
+			return types.StringValue(value)
+		}
+		return types.StringNull()
+	}
+
+	fullID, _ := conjurResource["id"].(string)
+	kind, name, _ := splitFullyQualifiedID(fullID)
+	return conjurResourceModel{
+		ID:          types.StringValue(fullID),
+		Kind:        types.StringValue(kind),
+		Name:        types.StringValue(name),
+		Owner:       field("owner"),
+		Policy:      field("policy"),
+		Annotations: recordAnnotations(conjurResource),
+		CreatedAt:   field("created_at"),
+	}
+}
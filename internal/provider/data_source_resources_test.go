@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourcesDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewResourcesDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+// testListedResources returns count variables as listed by the API, numbered from start
+func testListedResources(start, count int) []map[string]interface{} {
+	resources := make([]map[string]interface{}, 0, count)
+	for i := start; i < start+count; i++ {
+		resources = append(resources, map[string]interface{}{"id": fmt.Sprintf("myaccount:variable:data/secret-%d", i)})
+	}
+	return resources
+}
+
+func TestListResources(t *testing.T) {
+	t.Run("Pages through every matching resource", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "variable", Search: "db"}).Return(&conjurapi.ResourcesCount{Count: 250}, nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Search: "db", Limit: 100, Offset: 0}).Return(testListedResources(0, 100), nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Search: "db", Limit: 100, Offset: 100}).Return(testListedResources(100, 100), nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Search: "db", Limit: 50, Offset: 200}).Return(testListedResources(200, 50), nil).Once()
+
+		resources, err := listResources(mockV2, conjurapi.ResourceFilter{Kind: "variable", Search: "db"}, 0)
+
+		require.NoError(t, err)
+		assert.Len(t, resources, 250)
+		assert.Equal(t, "myaccount:variable:data/secret-249", resources[249]["id"])
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Offset and limit", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{}).Return(&conjurapi.ResourcesCount{Count: 250}, nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Limit: 100, Offset: 120}).Return(testListedResources(120, 100), nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Limit: 20, Offset: 220}).Return(testListedResources(220, 20), nil).Once()
+
+		resources, err := listResources(mockV2, conjurapi.ResourceFilter{Offset: 120}, 120)
+
+		require.NoError(t, err)
+		assert.Len(t, resources, 120)
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Resources deleted while listing", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: 150}, nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Limit: 100, Offset: 0}).Return(testListedResources(0, 100), nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Limit: 50, Offset: 100}).Return([]map[string]interface{}{}, nil).Once()
+
+		resources, err := listResources(mockV2, conjurapi.ResourceFilter{}, 0)
+
+		require.NoError(t, err)
+		assert.Len(t, resources, 100)
+	})
+
+	t.Run("Offset past the end", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: 10}, nil).Once()
+
+		resources, err := listResources(mockV2, conjurapi.ResourceFilter{Offset: 20}, 0)
+
+		require.NoError(t, err)
+		assert.Empty(t, resources)
+	})
+}
+
+func TestConjurResourceModelFrom(t *testing.T) {
+	model := conjurResourceModelFrom(map[string]interface{}{
+		"id":         "myaccount:variable:data/apps/db-password",
+		"owner":      "myaccount:policy:data/apps",
+		"policy":     "myaccount:policy:data/apps",
+		"created_at": "2026-01-01T00:00:00.000+00:00",
+		"annotations": []interface{}{
+			map[string]interface{}{"name": "description", "value": "Database password", "policy": "myaccount:policy:data/apps"},
+		},
+	})
+
+	assert.Equal(t, types.StringValue("myaccount:variable:data/apps/db-password"), model.ID)
+	assert.Equal(t, types.StringValue("variable"), model.Kind)
+	assert.Equal(t, types.StringValue("data/apps/db-password"), model.Name)
+	assert.Equal(t, types.StringValue("myaccount:policy:data/apps"), model.Owner)
+	assert.Equal(t, types.StringValue("myaccount:policy:data/apps"), model.Policy)
+	assert.Equal(t, map[string]string{"description": "Database password"}, model.Annotations)
+	assert.Equal(t, types.StringValue("2026-01-01T00:00:00.000+00:00"), model.CreatedAt)
+
+	// Resources listed by roles that can't see their owner leave it empty
+	model = conjurResourceModelFrom(map[string]interface{}{"id": "myaccount:host:data/apps/app-1"})
+	assert.True(t, model.Owner.IsNull())
+	assert.Empty(t, model.Annotations)
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestResourcesDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		role          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedIDs   []string
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "filtered resources",
+			role: "host:data/apps/app-1",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("GetConfig").Return(conjurapi.Config{Account: "myaccount"})
+				mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "variable", Role: "myaccount:host:data/apps/app-1"}).Return(&conjurapi.ResourcesCount{Count: 2}, nil)
+				mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Role: "myaccount:host:data/apps/app-1", Limit: 2}).Return([]map[string]interface{}{
+					{"id": "myaccount:variable:data/apps/db-password", "owner": "myaccount:policy:data/apps"},
+					{"id": "myaccount:variable:data/apps/db-username", "owner": "myaccount:policy:data/apps"},
+				}, nil)
+			},
+			expectedIDs: []string{"myaccount:variable:data/apps/db-password", "myaccount:variable:data/apps/db-username"},
+		},
+		{
+			name: "fully qualified role",
+			role: "myaccount:host:data/apps/app-1",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "variable", Role: "myaccount:host:data/apps/app-1"}).Return(&conjurapi.ResourcesCount{Count: 0}, nil)
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name: "no matching resources",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: 0}, nil)
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", mock.Anything).Return(nil, fmt.Errorf("401 Unauthorized"))
+			},
+			expectedError: true,
+			errorContains: "Failed to list resources",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &ResourcesDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getResourcesDataSourceTestSchema()
+			config := ResourcesDataSourceModel{
+				Kind:   types.StringValue("variable"),
+				Search: types.StringNull(),
+				Role:   types.StringNull(),
+				Limit:  types.Int64Null(),
+				Offset: types.Int64Null(),
+			}
+			if tt.role != "" {
+				config.Role = types.StringValue(tt.role)
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result ResourcesDataSourceModel
+				resp.State.Get(ctx, &result)
+				ids := []string{}
+				for _, r := range result.Resources {
+					ids = append(ids, r.ID.ValueString())
+				}
+				assert.Equal(t, tt.expectedIDs, ids)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getResourcesDataSourceTestSchema() schema.Schema {
+	d := &ResourcesDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &RoleMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &RoleMembersDataSource{}
+)
+
+func NewRoleMembersDataSource() datasource.DataSource {
+	return &RoleMembersDataSource{}
+}
+
+type RoleMembersDataSource struct {
+	client api.ClientV2
+}
+
+type RoleMembersDataSourceModel struct {
+	Role    types.String     `tfsdk:"role"`
+	Members []roleGrantModel `tfsdk:"members"`
+}
+
+// roleGrantModel describes a grant of a role to a member, as returned by the role members and memberships APIs
+type roleGrantModel struct {
+	Role        types.String `tfsdk:"role"`
+	Member      types.String `tfsdk:"member"`
+	AdminOption types.Bool   `tfsdk:"admin_option"`
+	Ownership   types.Bool   `tfsdk:"ownership"`
+	Policy      types.String `tfsdk:"policy"`
+}
+
+// roleGrantAttributes returns the computed attributes describing a role grant
+func roleGrantAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"role": schema.StringAttribute{
+			Computed:    true,
+			Description: "fully qualified ID of the granted role",
+		},
+		"member": schema.StringAttribute{
+			Computed:    true,
+			Description: "fully qualified ID of the role the role is granted to",
+		},
+		"admin_option": schema.BoolAttribute{
+			Computed:    true,
+			Description: "whether the member can grant the role to other roles",
+		},
+		"ownership": schema.BoolAttribute{
+			Computed:    true,
+			Description: "whether the grant comes from the member owning the role",
+		},
+		"policy": schema.StringAttribute{
+			Computed:    true,
+			Description: "fully qualified ID of the policy that declared the grant",
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *RoleMembersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_members"
+}
+
+func (d *RoleMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Direct members of a role in CyberArk Secrets Manager, including the owner of the role.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "role to list the members of, as `kind:id` (e.g. `group:data/admins`) or fully qualified ID",
+			},
+			"members": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "grants of the role to its direct members, sorted by member",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleGrantAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *RoleMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *RoleMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data RoleMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.RoleMembers(data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read role members", fmt.Sprintf("Unable to read members of role %q: %s", data.Role.ValueString(), err))
+		return
+	}
+
+	data.Members = roleGrants(members)
+	sort.Slice(data.Members, func(i, j int) bool {
+		return data.Members[i].Member.ValueString() < data.Members[j].Member.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// roleGrants converts the grants returned by the role members or memberships API into their data source model
+func roleGrants(grants []map[string]interface{}) []roleGrantModel {
+	models := make([]roleGrantModel, 0, len(grants))
+	for _, grant := range grants {
+		role, _ := grant["role"].(string)
+		member, _ := grant["member"].(string)
+		adminOption, _ := grant["admin_option"].(bool)
+		ownership, _ := grant["ownership"].(bool)
+		policy := types.StringNull()
+		if value, ok := grant["policy"].(string); ok && value != "" {
+			policy = types.StringValue(value)
+		}
+		models = append(models, roleGrantModel{
+			Role:        types.StringValue(role),
+			Member:      types.StringValue(member),
+			AdminOption: types.BoolValue(adminOption),
+			Ownership:   types.BoolValue(ownership),
+			Policy:      policy,
+		})
+	}
+	return models
+}
+
+// qualifyRoleID returns the fully qualified ID of a role given as `kind:id` or already fully qualified
+func qualifyRoleID(client api.ClientV2, roleID string) string {
+	if len(strings.SplitN(roleID, ":", 3)) == 3 {
+		return roleID
+	}
+	return fmt.Sprintf("%s:%s", client.GetConfig().Account, roleID)
+}
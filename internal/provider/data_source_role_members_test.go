@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleMembersDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewRoleMembersDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestRoleGrants(t *testing.T) {
+	grants := roleGrants([]map[string]interface{}{
+		{
+			"role":         "myaccount:group:data/admins",
+			"member":       "myaccount:policy:data",
+			"admin_option": true,
+			"ownership":    true,
+		},
+		{
+			"role":         "myaccount:group:data/admins",
+			"member":       "myaccount:user:alice@data",
+			"admin_option": false,
+			"ownership":    false,
+			"policy":       "myaccount:policy:data",
+		},
+	})
+
+	assert.Equal(t, []roleGrantModel{
+		{
+			Role:        types.StringValue("myaccount:group:data/admins"),
+			Member:      types.StringValue("myaccount:policy:data"),
+			AdminOption: types.BoolValue(true),
+			Ownership:   types.BoolValue(true),
+			Policy:      types.StringNull(),
+		},
+		{
+			Role:        types.StringValue("myaccount:group:data/admins"),
+			Member:      types.StringValue("myaccount:user:alice@data"),
+			AdminOption: types.BoolValue(false),
+			Ownership:   types.BoolValue(false),
+			Policy:      types.StringValue("myaccount:policy:data"),
+		},
+	}, grants)
+}
+
+func TestQualifyRoleID(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("GetConfig").Return(conjurapi.Config{Account: "myaccount"}).Once()
+
+	assert.Equal(t, "myaccount:group:data/admins", qualifyRoleID(mockV2, "group:data/admins"))
+	assert.Equal(t, "otheraccount:group:data/admins", qualifyRoleID(mockV2, "otheraccount:group:data/admins"))
+	mockV2.AssertExpectations(t)
+}
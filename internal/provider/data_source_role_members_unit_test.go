@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleMembersDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name            string
+		setupMock       func(*mocks.MockClientV2)
+		expectedMembers []string
+		expectedError   bool
+		errorContains   string
+	}{
+		{
+			name: "members sorted by member",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleMembers", "group:data/admins").Return([]map[string]interface{}{
+					{"role": "myaccount:group:data/admins", "member": "myaccount:user:bob@data", "admin_option": false, "ownership": false},
+					{"role": "myaccount:group:data/admins", "member": "myaccount:policy:data", "admin_option": true, "ownership": true},
+					{"role": "myaccount:group:data/admins", "member": "myaccount:host:data/apps/app-1", "admin_option": true, "ownership": false},
+				}, nil)
+			},
+			expectedMembers: []string{"myaccount:host:data/apps/app-1", "myaccount:policy:data", "myaccount:user:bob@data"},
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleMembers", "group:data/admins").Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectedError: true,
+			errorContains: "Failed to read role members",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &RoleMembersDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getRoleMembersDataSourceTestSchema()
+			config := RoleMembersDataSourceModel{
+				Role: types.StringValue("group:data/admins"),
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result RoleMembersDataSourceModel
+				resp.State.Get(ctx, &result)
+				members := []string{}
+				for _, m := range result.Members {
+					members = append(members, m.Member.ValueString())
+				}
+				assert.Equal(t, tt.expectedMembers, members)
+				assert.True(t, result.Members[1].Ownership.ValueBool())
+				assert.True(t, result.Members[0].AdminOption.ValueBool())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getRoleMembersDataSourceTestSchema() schema.Schema {
+	d := &RoleMembersDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
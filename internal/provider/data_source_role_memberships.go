@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource              = &RoleMembershipsDataSource{}
+	_ datasource.DataSourceWithConfigure = &RoleMembershipsDataSource{}
+)
+
+func NewRoleMembershipsDataSource() datasource.DataSource {
+	return &RoleMembershipsDataSource{}
+}
+
+type RoleMembershipsDataSource struct {
+	client api.ClientV2
+}
+
+type RoleMembershipsDataSourceModel struct {
+	Role           types.String     `tfsdk:"role"`
+	Memberships    []roleGrantModel `tfsdk:"memberships"`
+	AllMemberships []string         `tfsdk:"all_memberships"`
+}
+
+// Metadata returns the data source type name.
+func (d *RoleMembershipsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role_memberships"
+}
+
+func (d *RoleMembershipsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Roles in CyberArk Secrets Manager that a role is a member of, both directly and through other roles.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Required:    true,
+				Description: "role to list the memberships of, as `kind:id` (e.g. `host:data/apps/app-1`) or fully qualified ID",
+			},
+			"memberships": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "grants of the roles the role is a direct member of, sorted by role",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: roleGrantAttributes(),
+				},
+			},
+			"all_memberships": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "fully qualified IDs of every role the role is a member of, directly or transitively, sorted; the role itself is not included",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *RoleMembershipsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *RoleMembershipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data RoleMembershipsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := data.Role.ValueString()
+	memberships, err := d.client.RoleMemberships(roleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read role memberships", fmt.Sprintf("Unable to read memberships of role %q: %s", roleID, err))
+		return
+	}
+	allMemberships, err := d.client.RoleMembershipsAll(roleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read role memberships", fmt.Sprintf("Unable to read transitive memberships of role %q: %s", roleID, err))
+		return
+	}
+
+	data.Memberships = roleGrants(memberships)
+	sort.Slice(data.Memberships, func(i, j int) bool {
+		return data.Memberships[i].Role.ValueString() < data.Memberships[j].Role.ValueString()
+	})
+	data.AllMemberships = transitiveMemberships(qualifyRoleID(d.client, roleID), allMemberships)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// transitiveMemberships returns the sorted recursive memberships of a role, without the role itself
+func transitiveMemberships(fqRoleID string, allMemberships []string) []string {
+	roles := make([]string, 0, len(allMemberships))
+	for _, role := range allMemberships {
+		if role != fqRoleID {
+			roles = append(roles, role)
+		}
+	}
+	sort.Strings(roles)
+	return roles
+}
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleMembershipsDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &datasource.SchemaResponse{}
+
+	NewRoleMembershipsDataSource().Schema(ctx, datasource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestTransitiveMemberships(t *testing.T) {
+	roles := transitiveMemberships("myaccount:host:data/apps/app-1", []string{
+		"myaccount:host:data/apps/app-1",
+		"myaccount:layer:data/apps/web",
+		"myaccount:group:data/admins",
+		"myaccount:group:data/apps/consumers",
+	})
+
+	assert.Equal(t, []string{
+		"myaccount:group:data/admins",
+		"myaccount:group:data/apps/consumers",
+		"myaccount:layer:data/apps/web",
+	}, roles)
+	assert.Equal(t, []string{}, transitiveMemberships("myaccount:host:data/apps/app-1", nil))
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoleMembershipsDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name                string
+		setupMock           func(*mocks.MockClientV2)
+		expectedMemberships []string
+		expectedAll         []string
+		expectedError       bool
+		errorContains       string
+	}{
+		{
+			name: "direct and transitive memberships",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("GetConfig").Return(conjurapi.Config{Account: "myaccount"})
+				mockV2.On("RoleMemberships", "host:data/apps/app-1").Return([]map[string]interface{}{
+					{"role": "myaccount:layer:data/apps/web", "member": "myaccount:host:data/apps/app-1", "admin_option": false, "ownership": false},
+					{"role": "myaccount:group:data/apps/consumers", "member": "myaccount:host:data/apps/app-1", "admin_option": false, "ownership": false},
+				}, nil)
+				mockV2.On("RoleMembershipsAll", "host:data/apps/app-1").Return([]string{
+					"myaccount:host:data/apps/app-1",
+					"myaccount:layer:data/apps/web",
+					"myaccount:group:data/apps/consumers",
+					"myaccount:group:data/admins",
+				}, nil)
+			},
+			expectedMemberships: []string{"myaccount:group:data/apps/consumers", "myaccount:layer:data/apps/web"},
+			expectedAll:         []string{"myaccount:group:data/admins", "myaccount:group:data/apps/consumers", "myaccount:layer:data/apps/web"},
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleMemberships", "host:data/apps/app-1").Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Failed to read role memberships",
+		},
+		{
+			name: "API error reading transitive memberships",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleMemberships", "host:data/apps/app-1").Return([]map[string]interface{}{}, nil)
+				mockV2.On("RoleMembershipsAll", "host:data/apps/app-1").Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Unable to read transitive memberships",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &RoleMembershipsDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getRoleMembershipsDataSourceTestSchema()
+			config := RoleMembershipsDataSourceModel{
+				Role: types.StringValue("host:data/apps/app-1"),
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result RoleMembershipsDataSourceModel
+				resp.State.Get(ctx, &result)
+				memberships := []string{}
+				for _, m := range result.Memberships {
+					memberships = append(memberships, m.Role.ValueString())
+				}
+				assert.Equal(t, tt.expectedMemberships, memberships)
+				assert.Equal(t, tt.expectedAll, result.AllMemberships)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getRoleMembershipsDataSourceTestSchema() schema.Schema {
+	d := &RoleMembershipsDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
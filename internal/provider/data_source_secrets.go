@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &SecretsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &SecretsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &SecretsDataSource{}
+)
+
+func NewSecretsDataSource() datasource.DataSource {
+	return &SecretsDataSource{}
+}
+
+type SecretsDataSource struct {
+	client api.ClientV2
+}
+
+type SecretsDataSourceModel struct {
+	secretsRequestModel
+
+	Values  map[string]types.String `tfsdk:"values"`
+	Missing []types.String          `tfsdk:"missing"`
+}
+
+// Metadata returns the resource type name.
+func (d *SecretsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (d *SecretsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Multiple secrets from CyberArk Secrets Manager, retrieved in a single batch request",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "names (paths) of the secrets; values are keyed by name. Conflicts with variables",
+			},
+			"variables": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "map of alias to secret name (path); values are keyed by alias. Conflicts with names",
+			},
+			"safe": schema.BoolAttribute{
+				Optional:    true,
+				Description: "retrieve the secrets base64 encoded so that binary values survive the batch request; `values` then hold the base64 text, to decode with `base64decode` (default: false)",
+			},
+			"ignore_missing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "omit secrets whose variable does not exist instead of failing (default: false)",
+			},
+			"values": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "values of the secrets",
+			},
+			"missing": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "names of the secrets that were skipped because their variable does not exist",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this datasource.
+func (d *SecretsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	d.client = client
+}
+
+func (d *SecretsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data SecretsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.validate(&resp.Diagnostics)
+}
+
+func (d *SecretsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data SecretsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := data.variableIDs()
+	tflog.Debug(ctx, "Getting secrets in batch", map[string]interface{}{"count": len(ids)})
+
+	secrets, missing, err := retrieveSecrets(d.client, ids, data.Safe.ValueBool(), data.IgnoreMissing.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve secrets", err.Error())
+		return
+	}
+
+	data.Values = data.values(secrets)
+	data.Missing = make([]types.String, len(missing))
+	for i, id := range missing {
+		data.Missing[i] = types.StringValue(id)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretsDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         secretsRequestModel
+		setupMock       func(*mocks.MockClientV2)
+		expectedError   bool
+		errorContains   string
+		expectedValues  map[string]string
+		expectedMissing []string
+	}{
+		{
+			name:    "names keyed by variable ID",
+			request: testSecretsRequest([]string{"db/user", "db/password"}, nil, false, false),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecrets", []string{"db/password", "db/user"}).Return(map[string][]byte{
+					"myaccount:variable:db/password": []byte("secret"),
+					"myaccount:variable:db/user":     []byte("admin"),
+				}, nil)
+			},
+			expectedValues: map[string]string{"db/user": "admin", "db/password": "secret"},
+		},
+		{
+			name:    "variables keyed by alias",
+			request: testSecretsRequest(nil, map[string]string{"user": "db/user", "also_user": "db/user"}, false, false),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecrets", []string{"db/user"}).Return(map[string][]byte{
+					"myaccount:variable:db/user": []byte("admin"),
+				}, nil)
+			},
+			expectedValues: map[string]string{"user": "admin", "also_user": "admin"},
+		},
+		{
+			name:    "safe retrieval",
+			request: testSecretsRequest([]string{"certs/key"}, nil, true, false),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecretsSafe", []string{"certs/key"}).Return(map[string][]byte{
+					"myaccount:variable:certs/key": []byte("\x00\x01binary"),
+				}, nil)
+			},
+			expectedValues: map[string]string{"certs/key": "AAFiaW5hcnk="},
+		},
+		{
+			name:    "safe retrieval of non-UTF-8 values",
+			request: testSecretsRequest(nil, map[string]string{"der": "certs/der"}, true, false),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecretsSafe", []string{"certs/der"}).Return(map[string][]byte{
+					"myaccount:variable:certs/der": {0x30, 0x82, 0xff, 0xfe},
+				}, nil)
+			},
+			expectedValues: map[string]string{"der": "MIL//g=="},
+		},
+		{
+			name:    "batch error",
+			request: testSecretsRequest([]string{"db/user", "db/missing"}, nil, false, false),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecrets", []string{"db/missing", "db/user"}).Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectedError: true,
+			errorContains: "Failed to retrieve secrets",
+		},
+		{
+			name:    "ignore missing retries without missing variables",
+			request: testSecretsRequest([]string{"db/user", "db/missing"}, nil, false, true),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("GetConfig").Return(conjurapi.Config{Account: "myaccount"})
+				mockV2.On("RetrieveBatchSecrets", []string{"db/missing", "db/user"}).Return(nil, fmt.Errorf("404 Not Found")).Once()
+				mockV2.On("ResourceExists", "myaccount:variable:db/missing").Return(false, nil)
+				mockV2.On("ResourceExists", "myaccount:variable:db/user").Return(true, nil)
+				mockV2.On("RetrieveBatchSecrets", []string{"db/user"}).Return(map[string][]byte{
+					"myaccount:variable:db/user": []byte("admin"),
+				}, nil).Once()
+			},
+			expectedValues:  map[string]string{"db/user": "admin"},
+			expectedMissing: []string{"db/missing"},
+		},
+		{
+			name:    "ignore missing still fails when all variables exist",
+			request: testSecretsRequest([]string{"db/empty"}, nil, false, true),
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("GetConfig").Return(conjurapi.Config{Account: "myaccount"})
+				mockV2.On("RetrieveBatchSecrets", []string{"db/empty"}).Return(nil, fmt.Errorf("404 Not Found"))
+				mockV2.On("ResourceExists", "myaccount:variable:db/empty").Return(true, nil)
+			},
+			expectedError: true,
+			errorContains: "404 Not Found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			d := &SecretsDataSource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getSecretsDataSourceTestSchema()
+			config := SecretsDataSourceModel{
+				secretsRequestModel: tt.request,
+			}
+
+			req := datasource.ReadRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &datasource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			d.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result SecretsDataSourceModel
+				resp.State.Get(ctx, &result)
+				values := map[string]string{}
+				for k, v := range result.Values {
+					values[k] = v.ValueString()
+				}
+				assert.Equal(t, tt.expectedValues, values)
+				var missing []string
+				for _, v := range result.Missing {
+					missing = append(missing, v.ValueString())
+				}
+				assert.Equal(t, tt.expectedMissing, missing)
+			}
+
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSecretsRequestModel_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		request       secretsRequestModel
+		errorContains string
+	}{
+		{
+			name:    "names only",
+			request: testSecretsRequest([]string{"db/user"}, nil, false, false),
+		},
+		{
+			name:    "variables only",
+			request: testSecretsRequest(nil, map[string]string{"user": "db/user"}, false, false),
+		},
+		{
+			name:          "neither",
+			request:       testSecretsRequest(nil, nil, false, false),
+			errorContains: "Exactly one of 'names' or 'variables'",
+		},
+		{
+			name:          "both",
+			request:       testSecretsRequest([]string{"db/user"}, map[string]string{"user": "db/user"}, false, false),
+			errorContains: "Cannot set both 'names' and 'variables'",
+		},
+		{
+			name:          "empty name",
+			request:       testSecretsRequest([]string{" "}, nil, false, false),
+			errorContains: "cannot be empty",
+		},
+		{
+			name: "unknown names",
+			request: secretsRequestModel{
+				Names:     types.ListUnknown(types.StringType),
+				Variables: types.MapNull(types.StringType),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			tt.request.validate(&diags)
+			if tt.errorContains == "" {
+				assert.False(t, diags.HasError(), "%+v", diags)
+			} else {
+				assert.True(t, diagnosticsContain(diags, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			}
+		})
+	}
+}
+
+func TestShortVariableID(t *testing.T) {
+	assert.Equal(t, "db/password", shortVariableID("myaccount:variable:db/password"))
+	assert.Equal(t, "db:password", shortVariableID("myaccount:variable:db:password"))
+	assert.Equal(t, "db/password", shortVariableID("db/password"))
+}
+
+func testSecretsRequest(names []string, variables map[string]string, safe, ignoreMissing bool) secretsRequestModel {
+	request := secretsRequestModel{
+		Names:         types.ListNull(types.StringType),
+		Variables:     types.MapNull(types.StringType),
+		Safe:          types.BoolValue(safe),
+		IgnoreMissing: types.BoolValue(ignoreMissing),
+	}
+	if names != nil {
+		elems := make([]attr.Value, len(names))
+		for i, n := range names {
+			elems[i] = types.StringValue(n)
+		}
+		request.Names = types.ListValueMust(types.StringType, elems)
+	}
+	if variables != nil {
+		elems := make(map[string]attr.Value, len(variables))
+		for k, v := range variables {
+			elems[k] = types.StringValue(v)
+		}
+		request.Variables = types.MapValueMust(types.StringType, elems)
+	}
+	return request
+}
+
+func getSecretsDataSourceTestSchema() schema.Schema {
+	d := &SecretsDataSource{}
+	var schemaResp datasource.SchemaResponse
+	d.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
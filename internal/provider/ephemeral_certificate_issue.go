@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralCertificateIssueResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralCertificateIssueResource{}
+)
+
+func NewEphemeralCertificateIssueResource() ephemeral.EphemeralResource {
+	return &EphemeralCertificateIssueResource{}
+}
+
+type EphemeralCertificateIssueResource struct {
+	client certificateIssuer
+}
+
+type EphemeralCertificateIssueResourceModel struct {
+	certificateIssueRequestModel
+
+	Certificate types.String   `tfsdk:"certificate"`
+	Chain       []types.String `tfsdk:"chain"`
+	PrivateKey  types.String   `tfsdk:"private_key"`
+}
+
+// Metadata returns the resource type name.
+func (r *EphemeralCertificateIssueResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_issue"
+}
+
+func (r *EphemeralCertificateIssueResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Issue a certificate using a Secrets Manager certificate issuer without writing it to Terraform state or plan files. " +
+			"A new certificate and private key are issued every time the resource is opened.",
+		Attributes: map[string]schema.Attribute{
+			"issuer_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the Secrets Manager issuer to use.",
+			},
+			"common_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Common Name for the issued certificate.",
+			},
+			"organization": schema.StringAttribute{Optional: true},
+			"org_units":    schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"locality":     schema.StringAttribute{Optional: true},
+			"state":        schema.StringAttribute{Optional: true},
+			"country":      schema.StringAttribute{Optional: true},
+			"key_type":     schema.StringAttribute{Optional: true, MarkdownDescription: "Key type (e.g., RSA or ECDSA)."},
+			"ttl":          schema.StringAttribute{Optional: true, MarkdownDescription: "Time-to-live for the certificate (e.g., '24h')."},
+			"zone":         schema.StringAttribute{Optional: true},
+			"dns_names":    schema.ListAttribute{ElementType: types.StringType, Optional: true},
+			"ip_addresses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"email_addresses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"uris": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+
+			// Outputs
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The issued certificate in PEM format.",
+			},
+			"chain": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Certificate chain returned by the issuer.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Private key in PEM format.",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this ephemeral resource.
+func (r *EphemeralCertificateIssueResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = &conjurAPIWrapper{client}
+}
+
+// Open issues a new certificate.
+func (r *EphemeralCertificateIssueResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data EphemeralCertificateIssueResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Issuing certificate via Secrets Manager", map[string]interface{}{
+		"issuer_name": data.IssuerName.ValueString(),
+		"common_name": data.CommonName.ValueString(),
+	})
+
+	certResp, err := r.client.CertificateIssue(data.IssuerName.ValueString(), data.issue())
+	if err != nil {
+		resp.Diagnostics.AddError("Error issuing certificate", err.Error())
+		return
+	}
+
+	data.Certificate = types.StringValue(certResp.Certificate)
+	data.PrivateKey = types.StringValue(certResp.PrivateKey)
+	data.Chain = make([]types.String, len(certResp.Chain))
+	for i, c := range certResp.Chain {
+		data.Chain[i] = types.StringValue(c)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEphemeralCertificateIssueResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewEphemeralCertificateIssueResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEphemeralCertificateIssueResource_Metadata(t *testing.T) {
+	resp := &ephemeral.MetadataResponse{}
+	NewEphemeralCertificateIssueResource().Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "conjur"}, resp)
+	assert.Equal(t, "conjur_certificate_issue", resp.TypeName)
+}
+
+func TestEphemeralCertificateIssueResource_SchemaMatchesDataSource(t *testing.T) {
+	ctx := context.Background()
+	ephemeralResp := &ephemeral.SchemaResponse{}
+	NewEphemeralCertificateIssueResource().Schema(ctx, ephemeral.SchemaRequest{}, ephemeralResp)
+	dataSourceResp := &datasource.SchemaResponse{}
+	NewCertificateIssueDataSource().Schema(ctx, datasource.SchemaRequest{}, dataSourceResp)
+
+	assert.Equal(t, slices.Sorted(maps.Keys(dataSourceResp.Schema.Attributes)), slices.Sorted(maps.Keys(ephemeralResp.Schema.Attributes)))
+}
+
+func TestEphemeralCertificateIssueResource_Open(t *testing.T) {
+	certPEM := testCertificatePEM(t, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	m := &mockCertificateIssuer{}
+	m.On("CertificateIssue", "my-issuer", mock.MatchedBy(func(issue conjurapi.Issue) bool {
+		return issue.Subject.CommonName == "app.example.com" && len(issue.AltNames.DNSNames) == 1
+	})).Return(&conjurapi.CertificateResponse{
+		Certificate: certPEM,
+		Chain:       []string{"chain-1"},
+		PrivateKey:  "key-data",
+	}, nil)
+
+	r := &EphemeralCertificateIssueResource{}
+	r.client = m
+
+	ctx := context.Background()
+	schemaResp := &ephemeral.SchemaResponse{}
+	r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+	testSchema := schemaResp.Schema
+
+	config := EphemeralCertificateIssueResourceModel{
+		certificateIssueRequestModel: certificateIssueRequestModel{
+			IssuerName:   types.StringValue("my-issuer"),
+			CommonName:   types.StringValue("app.example.com"),
+			Organization: types.StringNull(),
+			Locality:     types.StringNull(),
+			State:        types.StringNull(),
+			Country:      types.StringNull(),
+			KeyType:      types.StringNull(),
+			TTL:          types.StringNull(),
+			Zone:         types.StringNull(),
+			DNSNames:     []types.String{types.StringValue("app.example.com")},
+		},
+		Certificate: types.StringNull(),
+		PrivateKey:  types.StringNull(),
+	}
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+			Schema: testSchema,
+		},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+			Schema: testSchema,
+		},
+	}
+
+	r.Open(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	var result EphemeralCertificateIssueResourceModel
+	resp.Result.Get(ctx, &result)
+	assert.Equal(t, certPEM, result.Certificate.ValueString())
+	assert.Equal(t, "key-data", result.PrivateKey.ValueString())
+	assert.Len(t, result.Chain, 1)
+	m.AssertExpectations(t)
+}
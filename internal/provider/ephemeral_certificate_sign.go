@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralCertificateSignResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralCertificateSignResource{}
+)
+
+func NewEphemeralCertificateSignResource() ephemeral.EphemeralResource {
+	return &EphemeralCertificateSignResource{}
+}
+
+type EphemeralCertificateSignResource struct {
+	client certificateSigner
+}
+
+type EphemeralCertificateSignResourceModel struct {
+	certificateSignRequestModel
+
+	Certificate types.String   `tfsdk:"certificate"`
+	Chain       []types.String `tfsdk:"chain"`
+	PrivateKey  types.String   `tfsdk:"private_key"`
+}
+
+// Metadata returns the resource type name.
+func (r *EphemeralCertificateSignResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_sign"
+}
+
+func (r *EphemeralCertificateSignResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sign a certificate signing request (CSR) using a Secrets Manager certificate issuer without writing the result to Terraform state or plan files. " +
+			"The CSR is signed again every time the resource is opened.",
+		Attributes: map[string]schema.Attribute{
+			"issuer_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the Secrets Manager issuer to use for signing.",
+			},
+			"csr": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "PEM-encoded Certificate Signing Request to be signed.",
+			},
+			"zone": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional zone or policy path for the signing request.",
+			},
+			"ttl": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Time-to-live for the signed certificate (e.g., '24h').",
+			},
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The signed certificate in PEM format.",
+			},
+			"chain": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Certificate chain returned by the issuer.",
+			},
+			"private_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Private key, if returned by the issuer (usually empty when signing a CSR).",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this ephemeral resource.
+func (r *EphemeralCertificateSignResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = &conjurAPIWrapper{client}
+}
+
+// Open signs the configured CSR.
+func (r *EphemeralCertificateSignResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data EphemeralCertificateSignResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Signing ephemeral CSR via Secrets Manager issuer", map[string]interface{}{
+		"issuer_name": data.IssuerName.ValueString(),
+	})
+
+	signResp, err := r.client.CertificateSign(data.IssuerName.ValueString(), data.sign())
+	if err != nil {
+		resp.Diagnostics.AddError("Error signing certificate", err.Error())
+		return
+	}
+
+	data.Certificate = types.StringValue(signResp.Certificate)
+	data.PrivateKey = types.StringValue(signResp.PrivateKey)
+	data.Chain = make([]types.String, len(signResp.Chain))
+	for i, c := range signResp.Chain {
+		data.Chain[i] = types.StringValue(c)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
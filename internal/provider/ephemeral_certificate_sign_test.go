@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralCertificateSignResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewEphemeralCertificateSignResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEphemeralCertificateSignResource_Open(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mockCertificateSigner)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful signing",
+			setupMock: func(m *mockCertificateSigner) {
+				m.On("CertificateSign", "my-issuer", conjurapi.Sign{Csr: "csr-data", TTL: "24h"}).Return(&conjurapi.CertificateResponse{
+					Certificate: "cert-data",
+					Chain:       []string{"chain-1", "chain-2"},
+				}, nil)
+			},
+		},
+		{
+			name: "issuer error",
+			setupMock: func(m *mockCertificateSigner) {
+				m.On("CertificateSign", "my-issuer", conjurapi.Sign{Csr: "csr-data", TTL: "24h"}).Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Error signing certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mockCertificateSigner{}
+			tt.setupMock(m)
+
+			r := &EphemeralCertificateSignResource{
+				client: m,
+			}
+
+			ctx := context.Background()
+			schemaResp := &ephemeral.SchemaResponse{}
+			r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+			testSchema := schemaResp.Schema
+
+			config := EphemeralCertificateSignResourceModel{
+				certificateSignRequestModel: certificateSignRequestModel{
+					IssuerName: types.StringValue("my-issuer"),
+					Csr:        types.StringValue("csr-data"),
+					Zone:       types.StringNull(),
+					TTL:        types.StringValue("24h"),
+				},
+				Certificate: types.StringNull(),
+				PrivateKey:  types.StringNull(),
+			}
+
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			r.Open(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result EphemeralCertificateSignResourceModel
+				resp.Result.Get(ctx, &result)
+				assert.Equal(t, "cert-data", result.Certificate.ValueString())
+				assert.Len(t, result.Chain, 2)
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestEphemeralCertificateResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewEphemeralCertificateResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEphemeralCertificateResource_Open(t *testing.T) {
+	notAfter := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	certPEM := testCertificatePEM(t, notAfter)
+
+	tests := []struct {
+		name          string
+		setupMock     func(*mockCertificateIssuer)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful issuance",
+			setupMock: func(m *mockCertificateIssuer) {
+				m.On("CertificateIssue", "my-issuer", mock.Anything).Return(&conjurapi.CertificateResponse{
+					Certificate: certPEM,
+					PrivateKey:  "key-data",
+				}, nil)
+			},
+		},
+		{
+			name: "issuer error",
+			setupMock: func(m *mockCertificateIssuer) {
+				m.On("CertificateIssue", "my-issuer", mock.Anything).Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectedError: true,
+			errorContains: "Error issuing certificate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &mockCertificateIssuer{}
+			tt.setupMock(m)
+
+			r := &EphemeralCertificateResource{
+				client: m,
+			}
+
+			ctx := context.Background()
+			testSchema := getEphemeralCertificateTestSchema()
+			config := EphemeralCertificateResourceModel{
+				certificateIssueRequestModel: certificateIssueRequestModel{
+					IssuerName:   types.StringValue("my-issuer"),
+					CommonName:   types.StringValue("app.example.com"),
+					Organization: types.StringNull(),
+					Locality:     types.StringNull(),
+					State:        types.StringNull(),
+					Country:      types.StringNull(),
+					KeyType:      types.StringNull(),
+					TTL:          types.StringNull(),
+					Zone:         types.StringNull(),
+				},
+				Certificate: types.StringNull(),
+				PrivateKey:  types.StringNull(),
+				NotAfter:    types.StringNull(),
+			}
+
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			r.Open(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result EphemeralCertificateResourceModel
+				resp.Result.Get(ctx, &result)
+				assert.Equal(t, certPEM, result.Certificate.ValueString())
+				assert.Equal(t, "key-data", result.PrivateKey.ValueString())
+				assert.Equal(t, "2027-01-01T00:00:00Z", result.NotAfter.ValueString())
+			}
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func getEphemeralCertificateTestSchema() schema.Schema {
+	r := &EphemeralCertificateResource{}
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
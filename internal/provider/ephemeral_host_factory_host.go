@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralHostFactoryHostResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralHostFactoryHostResource{}
+)
+
+func NewEphemeralHostFactoryHostResource() ephemeral.EphemeralResource {
+	return &EphemeralHostFactoryHostResource{}
+}
+
+type EphemeralHostFactoryHostResource struct {
+	client api.ClientV2
+}
+
+type EphemeralHostFactoryHostResourceModel struct {
+	Token       types.String `tfsdk:"token"`
+	ID          types.String `tfsdk:"id"`
+	Annotations types.Map    `tfsdk:"annotations"`
+	FullID      types.String `tfsdk:"full_id"`
+	Owner       types.String `tfsdk:"owner"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	APIKey      types.String `tfsdk:"api_key"`
+}
+
+// Metadata returns the resource type name.
+func (r *EphemeralHostFactoryHostResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_factory_host"
+}
+
+func (r *EphemeralHostFactoryHostResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral host created with a CyberArk Secrets Manager host factory token. The host's API key is NOT stored in Terraform state. " +
+			"The host is created (or, if it already exists, its API key is rotated) every time the resource is opened.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "host factory token used to create the host",
+			},
+			"id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the host, relative to the host factory's policy branch",
+			},
+			"annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "annotations to add to the host",
+			},
+			"full_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "full ID of the created host",
+			},
+			"owner": schema.StringAttribute{
+				Computed:    true,
+				Description: "owner of the created host",
+			},
+			"created_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "creation time of the host",
+			},
+			"api_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "API key of the host (not stored in state)",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this ephemeral resource.
+func (r *EphemeralHostFactoryHostResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+// Open creates the host with the host factory token and returns its API key.
+func (r *EphemeralHostFactoryHostResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data EphemeralHostFactoryHostResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var annotations map[string]string
+	if !data.Annotations.IsNull() {
+		resp.Diagnostics.Append(data.Annotations.ElementsAs(ctx, &annotations, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "creating host with host factory token", map[string]interface{}{"id": data.ID.ValueString()})
+
+	host, err := r.client.CreateHostWithAnnotations(data.ID.ValueString(), data.Token.ValueString(), annotations)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to create host", err.Error())
+		return
+	}
+
+	// The host ID is returned fully qualified (<account>:host:<id>)
+	fullID := host.Id
+	if _, id, err := splitFullyQualifiedID(host.Id); err == nil {
+		fullID = id
+	}
+
+	data.FullID = types.StringValue(fullID)
+	data.Owner = types.StringValue(host.Owner)
+	data.CreatedAt = types.StringValue(host.CreatedAt)
+	data.APIKey = types.StringValue(host.ApiKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
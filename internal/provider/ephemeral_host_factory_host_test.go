@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralHostFactoryHostResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewEphemeralHostFactoryHostResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEphemeralHostFactoryHostResource_Open(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         EphemeralHostFactoryHostResourceModel
+		setupMock      func(*mocks.MockClientV2)
+		expectedError  bool
+		errorContains  string
+		expectedFullID string
+	}{
+		{
+			name: "successful host creation",
+			config: EphemeralHostFactoryHostResourceModel{
+				Token:       types.StringValue("hf-token"),
+				ID:          types.StringValue("app-host"),
+				Annotations: types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("payments")}),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateHostWithAnnotations", "app-host", "hf-token", map[string]string{"team": "payments"}).Return(conjurapi.HostFactoryHostResponse{
+					Id:        "conjur:host:data/apps/app-host",
+					Owner:     "conjur:host_factory:data/apps/app-factory",
+					CreatedAt: "2026-01-01T00:00:00Z",
+					ApiKey:    "api-key-123",
+				}, nil)
+			},
+			expectedFullID: "data/apps/app-host",
+		},
+		{
+			name: "invalid token",
+			config: EphemeralHostFactoryHostResourceModel{
+				Token:       types.StringValue("expired"),
+				ID:          types.StringValue("app-host"),
+				Annotations: types.MapNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateHostWithAnnotations", "app-host", "expired", map[string]string(nil)).Return(
+					conjurapi.HostFactoryHostResponse{}, fmt.Errorf("401 Unauthorized"))
+			},
+			expectedError: true,
+			errorContains: "Failed to create host",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &EphemeralHostFactoryHostResource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getEphemeralHostFactoryHostTestSchema()
+			config := tt.config
+			config.FullID = types.StringNull()
+			config.Owner = types.StringNull()
+			config.CreatedAt = types.StringNull()
+			config.APIKey = types.StringNull()
+
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			r.Open(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result EphemeralHostFactoryHostResourceModel
+				resp.Result.Get(ctx, &result)
+				assert.Equal(t, tt.expectedFullID, result.FullID.ValueString())
+				assert.Equal(t, "api-key-123", result.APIKey.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getEphemeralHostFactoryHostTestSchema() schema.Schema {
+	r := &EphemeralHostFactoryHostResource{}
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// hostFactoryTokensKey is the private data key holding the tokens created on Open so they can be revoked on Close
+	hostFactoryTokensKey = "tokens"
+
+	defaultHostFactoryTokenDuration = "1h"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &EphemeralHostFactoryTokenResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &EphemeralHostFactoryTokenResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &EphemeralHostFactoryTokenResource{}
+)
+
+func NewEphemeralHostFactoryTokenResource() ephemeral.EphemeralResource {
+	return &EphemeralHostFactoryTokenResource{}
+}
+
+type EphemeralHostFactoryTokenResource struct {
+	client api.ClientV2
+}
+
+type EphemeralHostFactoryTokenResourceModel struct {
+	HostFactory types.String `tfsdk:"host_factory"`
+	Duration    types.String `tfsdk:"duration"`
+	Cidr        types.List   `tfsdk:"cidr"`
+	Count       types.Int64  `tfsdk:"token_count"`
+	Token       types.String `tfsdk:"token"`
+	Tokens      types.List   `tfsdk:"tokens"`
+	Expiration  types.String `tfsdk:"expiration"`
+}
+
+// Metadata returns the resource type name.
+func (r *EphemeralHostFactoryTokenResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_factory_token"
+}
+
+func (r *EphemeralHostFactoryTokenResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral host factory token from CyberArk Secrets Manager. Tokens are created when the resource is opened and revoked when it is closed, " +
+			"so they are NOT stored in Terraform state and only exist during the Terraform operation.",
+		Attributes: map[string]schema.Attribute{
+			"host_factory": schema.StringAttribute{
+				Required:    true,
+				Description: "full ID of the host factory (e.g. data/apps/my-factory)",
+			},
+			"duration": schema.StringAttribute{
+				Optional:    true,
+				Description: "how long the tokens are valid for, as a duration string (e.g. 30m, 2h). Defaults to 1h",
+			},
+			"cidr": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "CIDR ranges the tokens can be used from",
+			},
+			"token_count": schema.Int64Attribute{
+				Optional:    true,
+				Description: "number of tokens to create. Defaults to 1",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "the first created token (not stored in state)",
+			},
+			"tokens": schema.ListAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+				Description: "all created tokens (not stored in state)",
+			},
+			"expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "expiration time of the tokens",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this ephemeral resource.
+func (r *EphemeralHostFactoryTokenResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+// Open creates the host factory tokens. The tokens are kept in private data so Close can revoke them.
+func (r *EphemeralHostFactoryTokenResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data EphemeralHostFactoryTokenResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens := r.createTokens(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privateTokens, err := json.Marshal(tokens)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to store host factory tokens", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, hostFactoryTokensKey, privateTokens)...)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// Close revokes the tokens created by Open.
+func (r *EphemeralHostFactoryTokenResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	privateTokens, diags := req.Private.GetKey(ctx, hostFactoryTokensKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || privateTokens == nil {
+		return
+	}
+
+	var tokens []string
+	if err := json.Unmarshal(privateTokens, &tokens); err != nil {
+		resp.Diagnostics.AddError("Failed to read host factory tokens", err.Error())
+		return
+	}
+
+	r.deleteTokens(tokens, &resp.Diagnostics)
+}
+
+// createTokens creates the tokens described by the configuration, sets the computed attributes and returns the raw tokens
+func (r *EphemeralHostFactoryTokenResource) createTokens(ctx context.Context, data *EphemeralHostFactoryTokenResourceModel, diags *diag.Diagnostics) []string {
+	duration := defaultHostFactoryTokenDuration
+	if !data.Duration.IsNull() {
+		duration = data.Duration.ValueString()
+	}
+	if _, err := time.ParseDuration(duration); err != nil {
+		diags.AddError("Invalid duration", fmt.Sprintf("Unable to parse duration %q: %s", duration, err))
+		return nil
+	}
+
+	count := int64(1)
+	if !data.Count.IsNull() {
+		count = data.Count.ValueInt64()
+	}
+	if count < 1 {
+		diags.AddError("Invalid token_count", "token_count must be at least 1")
+		return nil
+	}
+
+	var cidrs []string
+	if !data.Cidr.IsNull() {
+		diags.Append(data.Cidr.ElementsAs(ctx, &cidrs, false)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	tflog.Debug(ctx, "creating host factory tokens", map[string]interface{}{"host_factory": data.HostFactory.ValueString(), "count": count})
+
+	tokenResp, err := r.client.CreateToken(duration, data.HostFactory.ValueString(), cidrs, int(count))
+	if err != nil {
+		diags.AddError("Failed to create host factory token", err.Error())
+		return nil
+	}
+	if len(tokenResp) == 0 {
+		diags.AddError("Failed to create host factory token", "Secrets Manager did not return any tokens")
+		return nil
+	}
+
+	tokens := make([]string, 0, len(tokenResp))
+	for _, t := range tokenResp {
+		tokens = append(tokens, t.Token)
+	}
+
+	tokenList, listDiags := types.ListValueFrom(ctx, types.StringType, tokens)
+	diags.Append(listDiags...)
+
+	data.Token = types.StringValue(tokens[0])
+	data.Tokens = tokenList
+	data.Expiration = types.StringValue(tokenResp[0].Expiration)
+	return tokens
+}
+
+// deleteTokens revokes every token, reporting each failure without stopping at the first one
+func (r *EphemeralHostFactoryTokenResource) deleteTokens(tokens []string, diags *diag.Diagnostics) {
+	for _, token := range tokens {
+		if err := r.client.DeleteToken(token); err != nil {
+			diags.AddError("Failed to delete host factory token", err.Error())
+		}
+	}
+}
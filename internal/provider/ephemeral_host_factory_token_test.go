@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEphemeralHostFactoryTokenResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewEphemeralHostFactoryTokenResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEphemeralHostFactoryTokenResource_createTokens(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         EphemeralHostFactoryTokenResourceModel
+		setupMock      func(*mocks.MockClientV2)
+		expectedError  bool
+		errorContains  string
+		expectedTokens []string
+	}{
+		{
+			name: "defaults to a single token valid for an hour",
+			config: EphemeralHostFactoryTokenResourceModel{
+				HostFactory: types.StringValue("data/apps/app-factory"),
+				Duration:    types.StringNull(),
+				Cidr:        types.ListNull(types.StringType),
+				Count:       types.Int64Null(),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateToken", "1h", "data/apps/app-factory", []string(nil), 1).Return([]conjurapi.HostFactoryTokenResponse{
+					{Token: "token-1", Expiration: "2026-01-01T01:00:00Z"},
+				}, nil)
+			},
+			expectedTokens: []string{"token-1"},
+		},
+		{
+			name: "multiple tokens restricted by CIDR",
+			config: EphemeralHostFactoryTokenResourceModel{
+				HostFactory: types.StringValue("data/apps/app-factory"),
+				Duration:    types.StringValue("30m"),
+				Cidr:        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/24")}),
+				Count:       types.Int64Value(2),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateToken", "30m", "data/apps/app-factory", []string{"10.0.0.0/24"}, 2).Return([]conjurapi.HostFactoryTokenResponse{
+					{Token: "token-1", Expiration: "2026-01-01T00:30:00Z"},
+					{Token: "token-2", Expiration: "2026-01-01T00:30:00Z"},
+				}, nil)
+			},
+			expectedTokens: []string{"token-1", "token-2"},
+		},
+		{
+			name: "invalid duration",
+			config: EphemeralHostFactoryTokenResourceModel{
+				HostFactory: types.StringValue("data/apps/app-factory"),
+				Duration:    types.StringValue("one hour"),
+				Cidr:        types.ListNull(types.StringType),
+				Count:       types.Int64Null(),
+			},
+			setupMock:     func(mockV2 *mocks.MockClientV2) {},
+			expectedError: true,
+			errorContains: "Invalid duration",
+		},
+		{
+			name: "invalid count",
+			config: EphemeralHostFactoryTokenResourceModel{
+				HostFactory: types.StringValue("data/apps/app-factory"),
+				Duration:    types.StringNull(),
+				Cidr:        types.ListNull(types.StringType),
+				Count:       types.Int64Value(0),
+			},
+			setupMock:     func(mockV2 *mocks.MockClientV2) {},
+			expectedError: true,
+			errorContains: "Invalid token_count",
+		},
+		{
+			name: "API error creating tokens",
+			config: EphemeralHostFactoryTokenResourceModel{
+				HostFactory: types.StringValue("data/apps/missing"),
+				Duration:    types.StringNull(),
+				Cidr:        types.ListNull(types.StringType),
+				Count:       types.Int64Null(),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateToken", "1h", "data/apps/missing", []string(nil), 1).Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectedError: true,
+			errorContains: "Failed to create host factory token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &EphemeralHostFactoryTokenResource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			var diags diag.Diagnostics
+			data := tt.config
+
+			tokens := r.createTokens(ctx, &data, &diags)
+
+			if tt.expectedError {
+				assert.True(t, diags.HasError())
+				assert.True(t, diagnosticsContain(diags, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, diags.HasError(), "Unexpected diagnostics: %+v", diags)
+				assert.Equal(t, tt.expectedTokens, tokens)
+				assert.Equal(t, tt.expectedTokens[0], data.Token.ValueString())
+				assert.Len(t, data.Tokens.Elements(), len(tt.expectedTokens))
+				assert.NotEmpty(t, data.Expiration.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEphemeralHostFactoryTokenResource_deleteTokens(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("DeleteToken", "token-1").Return(fmt.Errorf("404 Not Found"))
+	mockV2.On("DeleteToken", "token-2").Return(nil)
+
+	r := &EphemeralHostFactoryTokenResource{
+		client: mockV2,
+	}
+
+	var diags diag.Diagnostics
+	r.deleteTokens([]string{"token-1", "token-2"}, &diags)
+
+	// A failure revoking one token must not prevent the others from being revoked
+	assert.Len(t, diags.Errors(), 1)
+	mockV2.AssertExpectations(t)
+}
+
+func TestEphemeralHostFactoryTokenResource_Open_InvalidConfig(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	r := &EphemeralHostFactoryTokenResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	testSchema := getEphemeralHostFactoryTokenTestSchema()
+	config := EphemeralHostFactoryTokenResourceModel{
+		HostFactory: types.StringValue("data/apps/app-factory"),
+		Duration:    types.StringValue("soon"),
+		Cidr:        types.ListNull(types.StringType),
+		Count:       types.Int64Null(),
+		Token:       types.StringNull(),
+		Tokens:      types.ListNull(types.StringType),
+		Expiration:  types.StringNull(),
+	}
+
+	req := ephemeral.OpenRequest{
+		Config: tfsdk.Config{
+			Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+			Schema: testSchema,
+		},
+	}
+	resp := &ephemeral.OpenResponse{
+		Result: tfsdk.EphemeralResultData{
+			Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+			Schema: testSchema,
+		},
+	}
+
+	r.Open(ctx, req, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.True(t, diagnosticsContain(resp.Diagnostics, "Invalid duration"))
+	mockV2.AssertExpectations(t)
+}
+
+// testConfigValue converts a model into a raw configuration value for the schema of the given (empty) state
+func testConfigValue(t *testing.T, ctx context.Context, state tfsdk.State, model interface{}) tftypes.Value {
+	t.Helper()
+
+	state.Raw = tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)
+	diags := state.Set(ctx, model)
+	require.False(t, diags.HasError(), "Unable to build config: %+v", diags)
+	return state.Raw
+}
+
+// diagnosticsContain reports whether any error diagnostic mentions the given text in its summary or detail
+func diagnosticsContain(diags diag.Diagnostics, text string) bool {
+	for _, d := range diags.Errors() {
+		if strings.Contains(d.Summary(), text) || strings.Contains(d.Detail(), text) {
+			return true
+		}
+	}
+	return false
+}
+
+func getEphemeralHostFactoryTokenTestSchema() schema.Schema {
+	r := &EphemeralHostFactoryTokenResource{}
+	var schemaResp ephemeral.SchemaResponse
+	r.Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource                   = &EphemeralSecretsResource{}
+	_ ephemeral.EphemeralResourceWithConfigure      = &EphemeralSecretsResource{}
+	_ ephemeral.EphemeralResourceWithValidateConfig = &EphemeralSecretsResource{}
+)
+
+func NewEphemeralSecretsResource() ephemeral.EphemeralResource {
+	return &EphemeralSecretsResource{}
+}
+
+type EphemeralSecretsResource struct {
+	client api.ClientV2
+}
+
+type EphemeralSecretsResourceModel struct {
+	secretsRequestModel
+
+	Values  map[string]types.String `tfsdk:"values"`
+	Missing []types.String          `tfsdk:"missing"`
+}
+
+// Metadata returns the resource type name.
+func (r *EphemeralSecretsResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+}
+
+func (r *EphemeralSecretsResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral secrets from CyberArk Secrets Manager, retrieved in a single batch request. The secret values are NOT stored in Terraform state - they exist only during the Terraform operation.",
+		Attributes: map[string]schema.Attribute{
+			"names": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "names (paths) of the secrets; values are keyed by name. Conflicts with variables",
+			},
+			"variables": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "map of alias to secret name (path); values are keyed by alias. Conflicts with names",
+			},
+			"safe": schema.BoolAttribute{
+				Optional:    true,
+				Description: "retrieve the secrets base64 encoded so that binary values survive the batch request; `values` then hold the base64 text, to decode with `base64decode` (default: false)",
+			},
+			"ignore_missing": schema.BoolAttribute{
+				Optional:    true,
+				Description: "omit secrets whose variable does not exist instead of failing (default: false)",
+			},
+			"values": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "values of the secrets (not stored in state)",
+			},
+			"missing": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "names of the secrets that were skipped because their variable does not exist",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to this ephemeral resource.
+func (r *EphemeralSecretsResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *EphemeralSecretsResource) ValidateConfig(ctx context.Context, req ephemeral.ValidateConfigRequest, resp *ephemeral.ValidateConfigResponse) {
+	var data EphemeralSecretsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.validate(&resp.Diagnostics)
+}
+
+// Open retrieves the secret values. This is called during each Terraform operation
+// and the values are NOT stored in state - they exist only during the operation.
+func (r *EphemeralSecretsResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+
+	var data EphemeralSecretsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids := data.variableIDs()
+	tflog.Debug(ctx, "Getting secrets in batch", map[string]interface{}{"count": len(ids)})
+
+	secrets, missing, err := retrieveSecrets(r.client, ids, data.Safe.ValueBool(), data.IgnoreMissing.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to retrieve secrets", err.Error())
+		return
+	}
+
+	data.Values = data.values(secrets)
+	data.Missing = make([]types.String, len(missing))
+	for i, id := range missing {
+		data.Missing[i] = types.StringValue(id)
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEphemeralSecretsResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &ephemeral.SchemaResponse{}
+
+	NewEphemeralSecretsResource().Schema(ctx, ephemeral.SchemaRequest{}, schemaResponse)
+
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema method diagnostics: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation diagnostics: %+v", diagnostics)
+	}
+}
+
+func TestEphemeralSecretsResource_Open(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful batch retrieval",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecrets", []string{"db/password", "db/user"}).Return(map[string][]byte{
+					"myaccount:variable:db/password": []byte("secret"),
+					"myaccount:variable:db/user":     []byte("admin"),
+				}, nil)
+			},
+		},
+		{
+			name: "batch error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RetrieveBatchSecrets", []string{"db/password", "db/user"}).Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Failed to retrieve secrets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &EphemeralSecretsResource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			schemaResp := &ephemeral.SchemaResponse{}
+			r.Schema(ctx, ephemeral.SchemaRequest{}, schemaResp)
+			testSchema := schemaResp.Schema
+
+			config := EphemeralSecretsResourceModel{
+				secretsRequestModel: testSecretsRequest(nil, map[string]string{"user": "db/user", "password": "db/password"}, false, false),
+			}
+
+			req := ephemeral.OpenRequest{
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &config),
+					Schema: testSchema,
+				},
+			}
+			resp := &ephemeral.OpenResponse{
+				Result: tfsdk.EphemeralResultData{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			r.Open(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result EphemeralSecretsResourceModel
+				resp.Result.Get(ctx, &result)
+				assert.Equal(t, "admin", result.Values["user"].ValueString())
+				assert.Equal(t, "secret", result.Values["password"].ValueString())
+				assert.Empty(t, result.Missing)
+			}
+
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// shouldDryRunPolicy reports whether a planned change can and should be validated with a policy dry run.
+// Configurations with unknown values can't be rendered to policy yet, and unchanged resources have nothing to validate.
+func shouldDryRunPolicy(client api.ClientV2, req resource.ModifyPlanRequest) bool {
+	if client == nil {
+		return false
+	}
+	if req.Plan.Raw.IsNull() {
+		return !req.State.Raw.IsNull()
+	}
+	if !req.Config.Raw.IsFullyKnown() {
+		return false
+	}
+	return req.State.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw)
+}
+
+// dryRunPolicy validates a policy against the server without applying it. Syntax errors are added as
+// errors so the plan fails. References to records that don't exist yet are only warned about, since they may
+// be created earlier in the same apply. Updated and deleted records are reported as a warning, created ones are logged.
+func dryRunPolicy(ctx context.Context, client api.ClientV2, mode conjurapi.PolicyMode, policyDoc, branch string, diags *diag.Diagnostics) {
+	dryRunResp, err := policy.DryRunPolicy(client, mode, policyDoc, branch)
+	if err != nil {
+		// Dry run isn't available on SaaS or older servers, the policy will be validated on apply instead
+		if strings.Contains(err.Error(), "not supported") {
+			tflog.Debug(ctx, "skipping policy dry run", map[string]interface{}{"reason": err.Error()})
+			return
+		}
+		diags.AddWarning(
+			"Policy Dry Run Failed",
+			fmt.Sprintf("Unable to validate policy for branch %q before apply, got error: %s", branch, err),
+		)
+		return
+	}
+
+	if len(dryRunResp.Errors) > 0 {
+		for _, dryRunErr := range dryRunResp.Errors {
+			if isUnresolvedReferenceErr(dryRunErr.Message) {
+				diags.AddWarning(
+					"Unresolved Policy Reference",
+					fmt.Sprintf("Policy for branch %q references a record that doesn't exist yet at line %d, column %d: %s. "+
+						"The policy will fail on apply unless the record is created first.", branch, dryRunErr.Line, dryRunErr.Column, dryRunErr.Message),
+				)
+				continue
+			}
+			diags.AddError(
+				"Invalid Policy",
+				fmt.Sprintf("Policy for branch %q failed validation at line %d, column %d: %s", branch, dryRunErr.Line, dryRunErr.Column, dryRunErr.Message),
+			)
+		}
+		return
+	}
+
+	summary := summarizeDryRun(dryRunResp)
+	if summary == "" {
+		return
+	}
+	if len(dryRunResp.Updated.After.Items) == 0 && len(dryRunResp.Deleted.Items) == 0 {
+		tflog.Info(ctx, "policy dry run", map[string]interface{}{"branch": branch, "changes": summary})
+		return
+	}
+	diags.AddWarning(
+		"Policy Dry Run",
+		fmt.Sprintf("Loading policy into branch %q will make the following changes:\n%s", branch, summary),
+	)
+}
+
+// isUnresolvedReferenceErr reports whether a dry run error is about a record that doesn't exist,
+// rather than about the policy itself
+func isUnresolvedReferenceErr(message string) bool {
+	message = strings.ToLower(message)
+	return strings.Contains(message, "not found") || strings.Contains(message, "unresolved") || strings.Contains(message, "does not exist")
+}
+
+// summarizeDryRun lists the created, updated and deleted records of a dry run, one section per change type
+func summarizeDryRun(dryRunResp *conjurapi.DryRunPolicyResponse) string {
+	var sb strings.Builder
+	sections := []struct {
+		label string
+		items []conjurapi.Resource
+	}{
+		{"Created", dryRunResp.Created.Items},
+		{"Updated", dryRunResp.Updated.After.Items},
+		{"Deleted", dryRunResp.Deleted.Items},
+	}
+
+	for _, section := range sections {
+		if len(section.items) == 0 {
+			continue
+		}
+		sb.WriteString(section.label + ":\n")
+		for _, item := range section.items {
+			id := item.Identifier
+			if id == "" {
+				id = item.Id
+			}
+			sb.WriteString(fmt.Sprintf("  - %s\n", id))
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
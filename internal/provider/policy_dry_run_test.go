@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeDryRun(t *testing.T) {
+	t.Run("No changes", func(t *testing.T) {
+		assert.Empty(t, summarizeDryRun(&conjurapi.DryRunPolicyResponse{Status: "Valid YAML"}))
+	})
+
+	t.Run("All change types", func(t *testing.T) {
+		summary := summarizeDryRun(&conjurapi.DryRunPolicyResponse{
+			Created: conjurapi.DryRunPolicyResponseItems{Items: []conjurapi.Resource{{Identifier: "conjur:group:data/devs"}}},
+			Updated: conjurapi.DryRunPolicyUpdates{
+				Before: conjurapi.DryRunPolicyResponseItems{Items: []conjurapi.Resource{{Identifier: "conjur:policy:data"}}},
+				After:  conjurapi.DryRunPolicyResponseItems{Items: []conjurapi.Resource{{Identifier: "conjur:policy:data"}}},
+			},
+			Deleted: conjurapi.DryRunPolicyResponseItems{Items: []conjurapi.Resource{{Id: "data/old"}}},
+		})
+
+		assert.Equal(t, "Created:\n  - conjur:group:data/devs\nUpdated:\n  - conjur:policy:data\nDeleted:\n  - data/old", summary)
+	})
+}
+
+func TestIsUnresolvedReferenceErr(t *testing.T) {
+	assert.True(t, isUnresolvedReferenceErr("Group 'data/devs' not found in account 'conjur'"))
+	assert.True(t, isUnresolvedReferenceErr("Unresolved reference to !user alice"))
+	assert.False(t, isUnresolvedReferenceErr("Unrecognized data type '!gruop'"))
+	assert.False(t, isUnresolvedReferenceErr("mapping values are not allowed in this context"))
+}
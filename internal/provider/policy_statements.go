@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"gopkg.in/yaml.v3"
+)
+
+// The conjurpolicy library only models a subset of the policy language. The statements below cover
+// the records and attributes it is missing, and embed conjurpolicy.Resource so they can be mixed
+// into conjurpolicy.PolicyStatements alongside the library types.
+
+// policyUser is a `!user` record including the restricted_to attribute
+type policyUser struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Id                    string                   `yaml:"id"`
+	Owner                 conjurpolicy.ResourceRef `yaml:"owner,omitempty"`
+	RestrictedTo          []string                 `yaml:"restricted_to,omitempty,flow"`
+	Annotations           map[string]interface{}   `yaml:"annotations,omitempty"`
+}
+
+func (u policyUser) MarshalYAML() (interface{}, error) {
+	type plain policyUser
+	return marshalTaggedNode(plain(u), conjurpolicy.KindUser.Tag())
+}
+
+// policyLayer is a `!layer` record, the library's Layer type has no attributes
+type policyLayer struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Id                    string                   `yaml:"id"`
+	Owner                 conjurpolicy.ResourceRef `yaml:"owner,omitempty"`
+	Annotations           map[string]interface{}   `yaml:"annotations,omitempty"`
+}
+
+func (l policyLayer) MarshalYAML() (interface{}, error) {
+	type plain policyLayer
+	return marshalTaggedNode(plain(l), conjurpolicy.KindLayer.Tag())
+}
+
+// policyVariable is a `!variable` record including the mime_type and owner attributes
+type policyVariable struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Id                    string                   `yaml:"id"`
+	Kind                  string                   `yaml:"kind,omitempty"`
+	MimeType              string                   `yaml:"mime_type,omitempty"`
+	Owner                 conjurpolicy.ResourceRef `yaml:"owner,omitempty"`
+	Annotations           map[string]interface{}   `yaml:"annotations,omitempty"`
+}
+
+func (v policyVariable) MarshalYAML() (interface{}, error) {
+	type plain policyVariable
+	return marshalTaggedNode(plain(v), conjurpolicy.KindVariable.Tag())
+}
+
+// policyRevoke is a `!revoke` statement removing a member from a role
+type policyRevoke struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Role                  conjurpolicy.ResourceRef `yaml:"role"`
+	Member                conjurpolicy.ResourceRef `yaml:"member"`
+}
+
+func (r policyRevoke) MarshalYAML() (interface{}, error) {
+	type plain policyRevoke
+	return marshalTaggedNode(plain(r), "!revoke")
+}
+
+// policyGrant is a `!grant` statement whose member can carry the admin option
+type policyGrant struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Role                  conjurpolicy.ResourceRef `yaml:"role"`
+	Member                policyMember             `yaml:"member"`
+}
+
+func (g policyGrant) MarshalYAML() (interface{}, error) {
+	type plain policyGrant
+	return marshalTaggedNode(plain(g), conjurpolicy.KindGrant.Tag())
+}
+
+// policyMember is the member of a grant, emitted as a `!member` with `admin: true` when the admin option is set
+// and as a plain role reference otherwise
+type policyMember struct {
+	Role  conjurpolicy.ResourceRef `yaml:"role"`
+	Admin bool                     `yaml:"admin,omitempty"`
+}
+
+func (m policyMember) MarshalYAML() (interface{}, error) {
+	if !m.Admin {
+		return m.Role, nil
+	}
+	type plain policyMember
+	return marshalTaggedNode(plain(m), "!member")
+}
+
+// policyRef references a record by a kind that isn't part of the library's Kind enum (e.g. `host-factory`)
+type policyRef struct {
+	Kind string
+	Id   string
+}
+
+func (r policyRef) MarshalYAML() (interface{}, error) {
+	return &yaml.Node{
+		Kind:  yaml.ScalarNode,
+		Value: r.Id,
+		Tag:   "!" + r.Kind,
+		Style: yaml.TaggedStyle,
+	}, nil
+}
+
+// policyPermit is a `!permit` statement that can reference records and privileges unknown to the library (e.g. `!webservice`, `authenticate`)
+type policyPermit struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Role                  policyRef `yaml:"role"`
+	Privileges            []string  `yaml:"privileges,flow"`
+	Resources             policyRef `yaml:"resource"`
+}
+
+func (p policyPermit) MarshalYAML() (interface{}, error) {
+	type plain policyPermit
+	return marshalTaggedNode(plain(p), conjurpolicy.KindPermit.Tag())
+}
+
+// policyDeny is the `!deny` counterpart of policyPermit
+type policyDeny struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Role                  policyRef `yaml:"role"`
+	Privileges            []string  `yaml:"privileges,flow"`
+	Resources             policyRef `yaml:"resource"`
+}
+
+func (d policyDeny) MarshalYAML() (interface{}, error) {
+	type plain policyDeny
+	return marshalTaggedNode(plain(d), conjurpolicy.KindDeny.Tag())
+}
+
+// policyPermits is a `!permit` statement granting privileges to every role on every resource listed
+type policyPermits struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Role                  []policyRef `yaml:"role,flow"`
+	Privileges            []string    `yaml:"privileges,flow"`
+	Resources             []policyRef `yaml:"resource,flow"`
+}
+
+func (p policyPermits) MarshalYAML() (interface{}, error) {
+	type plain policyPermits
+	return marshalTaggedNode(plain(p), conjurpolicy.KindPermit.Tag())
+}
+
+// policyDenies is the `!deny` counterpart of policyPermits
+type policyDenies struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Role                  []policyRef `yaml:"role,flow"`
+	Privileges            []string    `yaml:"privileges,flow"`
+	Resources             []policyRef `yaml:"resource,flow"`
+}
+
+func (d policyDenies) MarshalYAML() (interface{}, error) {
+	type plain policyDenies
+	return marshalTaggedNode(plain(d), conjurpolicy.KindDeny.Tag())
+}
+
+// policyWebservice is a `!webservice` record
+type policyWebservice struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Id                    string                   `yaml:"id"`
+	Owner                 conjurpolicy.ResourceRef `yaml:"owner,omitempty"`
+	Annotations           map[string]interface{}   `yaml:"annotations,omitempty"`
+}
+
+func (w policyWebservice) MarshalYAML() (interface{}, error) {
+	type plain policyWebservice
+	return marshalTaggedNode(plain(w), "!webservice")
+}
+
+// policyDelete is a `!delete` statement for records that can't be referenced with conjurpolicy.ResourceRef
+type policyDelete struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Record                policyRef `yaml:"record"`
+}
+
+func (d policyDelete) MarshalYAML() (interface{}, error) {
+	type plain policyDelete
+	return marshalTaggedNode(plain(d), conjurpolicy.KindDelete.Tag())
+}
+
+// policyHostFactory is a `!host-factory` record
+type policyHostFactory struct {
+	conjurpolicy.Resource `yaml:"-"`
+	Id                    string                     `yaml:"id"`
+	Owner                 conjurpolicy.ResourceRef   `yaml:"owner,omitempty"`
+	Layers                []conjurpolicy.ResourceRef `yaml:"layers,flow"`
+	Annotations           map[string]interface{}     `yaml:"annotations,omitempty"`
+}
+
+func (h policyHostFactory) MarshalYAML() (interface{}, error) {
+	type plain policyHostFactory
+	return marshalTaggedNode(plain(h), "!host-factory")
+}
+
+// marshalTaggedNode encodes a statement as a YAML node carrying the given policy tag (e.g. `!user`)
+func marshalTaggedNode(v interface{}, tag string) (interface{}, error) {
+	node := &yaml.Node{}
+	if err := node.Encode(v); err != nil {
+		return nil, err
+	}
+
+	// Emit `- !tag` rather than `- !tag {}` for statements without attributes
+	if len(node.Content) == 0 {
+		node.Kind = yaml.ScalarNode
+	}
+
+	node.Tag = tag
+	node.Style = yaml.TaggedStyle
+	return node, nil
+}
+
+// policyOwnerRef converts an owner block into a policy reference, returning an empty reference when no owner is set
+func policyOwnerRef(owner *ConjurOwnerModel) (conjurpolicy.ResourceRef, error) {
+	if owner == nil {
+		return conjurpolicy.ResourceRef{}, nil
+	}
+	ownerKind, err := conjurpolicy.KindString(owner.Kind.ValueString())
+	if err != nil {
+		return conjurpolicy.ResourceRef{}, fmt.Errorf("invalid owner kind: %w", err)
+	}
+	return conjurpolicy.ResourceRef{
+		Kind: ownerKind,
+		Id:   owner.ID.ValueString(),
+	}, nil
+}
+
+// policyAnnotations converts annotations into the form expected by policy statements
+func policyAnnotations(annotations map[string]string) map[string]interface{} {
+	if len(annotations) == 0 {
+		return nil
+	}
+	result := make(map[string]interface{}, len(annotations))
+	for k, v := range annotations {
+		result[k] = v
+	}
+	return result
+}
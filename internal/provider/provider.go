@@ -379,8 +379,17 @@ func (p *conjurProvider) createAPIKeyClient(config *conjurapi.Config, data *conj
 func (p *conjurProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewSecretDataSource,
+		NewSecretsDataSource,
 		NewCertificateIssueDataSource,
 		NewCertificateSignDataSource,
+		NewCertificateIssuerDataSource,
+		NewCertificateIssuersDataSource,
+		NewResourcesDataSource,
+		NewResourceDataSource,
+		NewRoleMembersDataSource,
+		NewRoleMembershipsDataSource,
+		NewPermittedRolesDataSource,
+		NewEffectiveAccessDataSource,
 	}
 }
 
@@ -388,6 +397,12 @@ func (p *conjurProvider) DataSources(_ context.Context) []func() datasource.Data
 func (p *conjurProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
 	return []func() ephemeral.EphemeralResource{
 		NewEphemeralSecretResource,
+		NewEphemeralSecretsResource,
+		NewEphemeralHostFactoryTokenResource,
+		NewEphemeralHostFactoryHostResource,
+		NewEphemeralCertificateResource,
+		NewEphemeralCertificateIssueResource,
+		NewEphemeralCertificateSignResource,
 	}
 }
 
@@ -398,9 +413,21 @@ func (p *conjurProvider) Resources(_ context.Context) []func() resource.Resource
 		NewConjurHostResource,
 		NewConjurGroupResource,
 		NewConjurPermissionResource,
+		NewConjurPermissionsResource,
+		NewConjurBranchPermissionResource,
 		NewConjurMembershipResource,
+		NewConjurGroupMembersResource,
 		NewConjurSecretResource,
+		NewConjurVariableResource,
+		NewConjurWebserviceResource,
+		NewConjurGrantResource,
 		NewConjurPolicyBranchResource,
+		NewConjurPolicyResource,
+		NewConjurUserResource,
+		NewConjurLayerResource,
+		NewConjurHostFactoryResource,
+		NewConjurCertificateIssuerResource,
+		NewConjurCertificateResource,
 	}
 }
 
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// recordAnnotations returns the annotations of a record from a Conjur resource response
+func recordAnnotations(record map[string]interface{}) map[string]string {
+	annotations := map[string]string{}
+	rawAnnotations, _ := record["annotations"].([]interface{})
+	for _, a := range rawAnnotations {
+		annotation, _ := a.(map[string]interface{})
+		name, _ := annotation["name"].(string)
+		value, _ := annotation["value"].(string)
+		if name != "" {
+			annotations[name] = value
+		}
+	}
+	return annotations
+}
+
+// refreshRecordOwner updates a configured owner from a Conjur resource response. Nothing is
+// changed when no owner is configured, so the default owner doesn't cause a diff.
+func refreshRecordOwner(record map[string]interface{}, branch string, owner *ConjurOwnerModel) {
+	if owner == nil {
+		return
+	}
+	ownerID, _ := record["owner"].(string)
+	kind, id, err := splitFullyQualifiedID(ownerID)
+	if err != nil {
+		return
+	}
+	// Owners may be configured relative to the record's branch, so only replace them when they differ
+	configuredID := strings.Trim(owner.ID.ValueString(), "/")
+	if id != configuredID && id != joinConjurID(branch, configuredID) {
+		owner.ID = types.StringValue(id)
+	}
+	owner.Kind = types.StringValue(kind)
+}
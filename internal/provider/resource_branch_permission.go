@@ -0,0 +1,513 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurBranchPermissionResource{}
+	_ resource.ResourceWithConfigure      = &ConjurBranchPermissionResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurBranchPermissionResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurBranchPermissionResource{}
+)
+
+// branchPermissionKinds are the resource kinds whose IDs are prefixed by the branch they are declared in
+var branchPermissionKinds = []string{"variable", "host", "group", "layer", "policy", "webservice"}
+
+func NewConjurBranchPermissionResource() resource.Resource {
+	return &ConjurBranchPermissionResource{}
+}
+
+// ConjurBranchPermissionResource defines the resource implementation.
+type ConjurBranchPermissionResource struct {
+	client api.ClientV2
+}
+
+// ConjurBranchPermissionResourceModel describes the resource data model.
+type ConjurBranchPermissionResourceModel struct {
+	Role        RoleModel    `tfsdk:"role"`
+	Branch      types.String `tfsdk:"branch"`
+	Kind        types.String `tfsdk:"kind"`
+	Privileges  types.List   `tfsdk:"privileges"`
+	ResourceIDs types.Set    `tfsdk:"resource_ids"`
+}
+
+func (r *ConjurBranchPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_branch_permission"
+}
+
+func (r *ConjurBranchPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager branch permission resource. This resource permits privileges to a role on every resource of a kind under a policy branch, " +
+			"including nested branches. Resources created in the branch later are reported as drift on the next plan and permitted on apply.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.SingleNestedAttribute{
+				MarkdownDescription: "The role to permit the privileges to",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the role",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "The kind of the role",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"branch": schema.StringAttribute{
+						MarkdownDescription: "The policy branch of the role",
+						Required:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch whose resources the privileges are permitted on, e.g. `data/apps/payments`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "The kind of resources to permit the privileges on: `variable`, `host`, `group`, `layer`, `policy` or `webservice`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "List of privileges to permit on every resource, e.g. `read` and `execute`",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"resource_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the resources in the branch the privileges are permitted on",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ConjurBranchPermissionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurBranchPermissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.Role.Name, &resp.Diagnostics, "Role name")
+	ValidateContainedIn(data.Role.Kind, &resp.Diagnostics, "Role kind", permissionRoleKinds, false)
+	ValidateBranch(data.Role.Branch, &resp.Diagnostics, "role branch")
+
+	ValidateBranch(data.Branch, &resp.Diagnostics, "Resource")
+	ValidateContainedIn(data.Kind, &resp.Diagnostics, "Resource kind", branchPermissionKinds, false)
+
+	ValidatePrivileges(data.Privileges, &resp.Diagnostics, "privileges", nil)
+}
+
+func (r *ConjurBranchPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan lists the resources currently in the branch so that resources created since the last apply show up as a planned change
+func (r *ConjurBranchPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		if !shouldDryRunPolicy(r.client, req) {
+			return
+		}
+		var state ConjurBranchPermissionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		stateIDs := branchPermissionResourceIDs(ctx, state.ResourceIDs, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branch, permissionPolicy, err := generateBranchPermissionDenyPolicy(&state, stateIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Building Branch Permission Policy", fmt.Sprintf("Could not build Branch Permission policy: %s", err))
+			return
+		}
+		if permissionPolicy != "" {
+			dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, permissionPolicy, branch, &resp.Diagnostics)
+		}
+		return
+	}
+
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var data ConjurBranchPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources, err := sharedResourceCache.listBranch(r.client, data.Kind.ValueString(), data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list resources in branch %q, got error: %s", data.Branch.ValueString(), err))
+		return
+	}
+	ids := conjurResourceIDs(resources)
+	resourceIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("resource_ids"), resourceIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var previous *ConjurBranchPermissionResourceModel
+	if !req.State.Raw.IsNull() {
+		var state ConjurBranchPermissionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !shouldDryRunPolicy(r.client, req) && state.ResourceIDs.Equal(resourceIDs) {
+			return
+		}
+		previous = &state
+	}
+
+	previousIDs := []string{}
+	if previous != nil {
+		previousIDs = branchPermissionResourceIDs(ctx, previous.ResourceIDs, &resp.Diagnostics)
+	}
+	branch, permissionPolicy, err := generateBranchPermissionPolicy(&data, ids, previous, previousIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Branch Permission Policy", fmt.Sprintf("Could not build Branch Permission policy: %s", err))
+		return
+	}
+	if permissionPolicy != "" {
+		dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, permissionPolicy, branch, &resp.Diagnostics)
+	}
+}
+
+func (r *ConjurBranchPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurBranchPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, ok := r.plannedResourceIDs(ctx, &data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	branch, permissionPolicy, err := generateBranchPermissionPolicy(&data, ids, nil, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Branch Permission Policy", fmt.Sprintf("Could not build Branch Permission policy: %s", err))
+		return
+	}
+
+	if permissionPolicy != "" {
+		err = policy.ApplyPolicy(r.client, permissionPolicy, branch)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load Branch Permission policy, got error: %s", err))
+			return
+		}
+	}
+
+	resourceIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.ResourceIDs = resourceIDs
+
+	tflog.Trace(ctx, "created branch permission resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurBranchPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurBranchPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources, err := sharedResourceCache.listBranch(r.client, data.Kind.ValueString(), data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list resources in branch %q, got error: %s", data.Branch.ValueString(), err))
+		return
+	}
+
+	// Only resources the role holds every privilege on are kept, the others are permitted again on the next apply
+	privileges := normalizePrivileges(data.Privileges)
+	roleKind := data.Role.Kind.ValueString()
+	roleID := conjurRecordID(roleKind, data.Role.Branch.ValueString(), data.Role.Name.ValueString())
+	var permitted []map[string]interface{}
+	for _, conjurResource := range resources {
+		granted := rolePrivilegesOnResource(conjurResource, roleKind, roleID)
+		missing := slices.ContainsFunc(privileges, func(priv string) bool {
+			return !slices.Contains(granted, priv)
+		})
+		if !missing {
+			permitted = append(permitted, conjurResource)
+		}
+	}
+
+	resourceIDs, diags := types.SetValueFrom(ctx, types.StringType, conjurResourceIDs(permitted))
+	resp.Diagnostics.Append(diags...)
+	data.ResourceIDs = resourceIDs
+
+	tflog.Trace(ctx, "read branch permission resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurBranchPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state ConjurBranchPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, ok := r.plannedResourceIDs(ctx, &data, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	stateIDs := branchPermissionResourceIDs(ctx, state.ResourceIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, permissionPolicy, err := generateBranchPermissionPolicy(&data, ids, &state, stateIDs)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Branch Permission Policy", fmt.Sprintf("Could not build Branch Permission policy: %s", err))
+		return
+	}
+
+	if permissionPolicy != "" {
+		err = policy.ApplyPolicy(r.client, permissionPolicy, branch)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load Branch Permission policy, got error: %s", err))
+			return
+		}
+	}
+
+	resourceIDs, diags := types.SetValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	data.ResourceIDs = resourceIDs
+
+	tflog.Trace(ctx, "updated branch permission resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurBranchPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurBranchPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	ids := branchPermissionResourceIDs(ctx, data.ResourceIDs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, permissionPolicy, err := generateBranchPermissionDenyPolicy(&data, ids)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Branch Permission Delete Policy", fmt.Sprintf("Could not build Branch Permission Delete policy: %s", err))
+		return
+	}
+
+	if permissionPolicy != "" {
+		err = policy.ApplyPolicy(r.client, permissionPolicy, branch)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load Branch Permission policy, got error: %s", err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "deleted branch permission resource")
+}
+
+// plannedResourceIDs returns the resource IDs listed during plan, or lists the branch if they weren't known yet
+func (r *ConjurBranchPermissionResource) plannedResourceIDs(ctx context.Context, data *ConjurBranchPermissionResourceModel, diags *diag.Diagnostics) ([]string, bool) {
+	if !data.ResourceIDs.IsUnknown() && !data.ResourceIDs.IsNull() {
+		ids := branchPermissionResourceIDs(ctx, data.ResourceIDs, diags)
+		return ids, !diags.HasError()
+	}
+
+	resources, err := sharedResourceCache.listBranch(r.client, data.Kind.ValueString(), data.Branch.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list resources in branch %q, got error: %s", data.Branch.ValueString(), err))
+		return nil, false
+	}
+	return conjurResourceIDs(resources), true
+}
+
+// branchPermissionResourceIDs returns the sorted resource IDs of a resource_ids set
+func branchPermissionResourceIDs(ctx context.Context, set types.Set, diags *diag.Diagnostics) []string {
+	ids := []string{}
+	if set.IsNull() || set.IsUnknown() {
+		return ids
+	}
+	diags.Append(set.ElementsAs(ctx, &ids, false)...)
+	slices.Sort(ids)
+	return ids
+}
+
+// listBranchResources returns every resource of a kind declared in a branch or its nested branches.
+// The server only filters by search text, which also matches annotations, so the IDs are checked against the branch.
+func listBranchResources(client api.ClientV2, kind, branch string) ([]map[string]interface{}, error) {
+	filter := conjurapi.ResourceFilter{Kind: kind}
+	prefix := strings.Trim(branch, "/") + "/"
+	if prefix == "root/" {
+		prefix = ""
+	} else {
+		filter.Search = strings.Trim(branch, "/")
+	}
+
+	all, err := listResources(client, filter, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []map[string]interface{}
+	for _, conjurResource := range all {
+		fullID, _ := conjurResource["id"].(string)
+		resourceKind, id, err := splitFullyQualifiedID(fullID)
+		if err != nil || resourceKind != kind || !strings.HasPrefix(id, prefix) {
+			continue
+		}
+		resources = append(resources, conjurResource)
+	}
+	return resources, nil
+}
+
+// conjurResourceIDs returns the sorted IDs, without account and kind, of resources returned by the API
+func conjurResourceIDs(resources []map[string]interface{}) []string {
+	ids := []string{}
+	for _, conjurResource := range resources {
+		fullID, _ := conjurResource["id"].(string)
+		if _, id, err := splitFullyQualifiedID(fullID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// generateBranchPermissionPolicy creates a policy permitting the privileges on every resource listed in the branch.
+// Privileges removed since the previous state are denied on the resources that were permitted before.
+// An empty policy is returned when there is nothing to load.
+func generateBranchPermissionPolicy(data *ConjurBranchPermissionResourceModel, ids []string, previous *ConjurBranchPermissionResourceModel, previousIDs []string) (string, string, error) {
+	branch := mergePolicyBranch(data.Role.Branch.ValueString(), data.Branch.ValueString())
+	role := relativeRecordRefs(branch, []RoleModel{data.Role})
+	kind := data.Kind.ValueString()
+	privileges := normalizePrivileges(data.Privileges)
+
+	var statements conjurpolicy.PolicyStatements
+	if len(ids) > 0 && len(privileges) > 0 {
+		statements = append(statements, policyPermits{
+			Role:       role,
+			Privileges: privileges,
+			Resources:  relativeResourceRefs(branch, kind, ids),
+		})
+	}
+
+	if previous != nil {
+		removedPrivileges := slices.DeleteFunc(normalizePrivileges(previous.Privileges), func(priv string) bool {
+			return slices.Contains(privileges, priv)
+		})
+		// Resources deleted from the branch since the last apply can't be referenced anymore
+		stillPresent := slices.DeleteFunc(slices.Clone(previousIDs), func(id string) bool {
+			return !slices.Contains(ids, id)
+		})
+		if len(removedPrivileges) > 0 && len(stillPresent) > 0 {
+			statements = append(statements, policyDenies{
+				Role:       role,
+				Privileges: removedPrivileges,
+				Resources:  relativeResourceRefs(branch, kind, stillPresent),
+			})
+		}
+	}
+
+	return marshalBranchPermissionPolicy(branch, statements)
+}
+
+// generateBranchPermissionDenyPolicy creates a policy that denies the privileges on every permitted resource
+func generateBranchPermissionDenyPolicy(data *ConjurBranchPermissionResourceModel, ids []string) (string, string, error) {
+	branch := mergePolicyBranch(data.Role.Branch.ValueString(), data.Branch.ValueString())
+	privileges := normalizePrivileges(data.Privileges)
+
+	var statements conjurpolicy.PolicyStatements
+	if len(ids) > 0 && len(privileges) > 0 {
+		statements = append(statements, policyDenies{
+			Role:       relativeRecordRefs(branch, []RoleModel{data.Role}),
+			Privileges: privileges,
+			Resources:  relativeResourceRefs(branch, data.Kind.ValueString(), ids),
+		})
+	}
+
+	return marshalBranchPermissionPolicy(branch, statements)
+}
+
+func marshalBranchPermissionPolicy(branch string, statements conjurpolicy.PolicyStatements) (string, string, error) {
+	if len(statements) == 0 {
+		return branch, "", nil
+	}
+	yamlBytes, err := yaml.Marshal(statements)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal policy: %w", err)
+	}
+	return branch, string(yamlBytes), nil
+}
+
+// relativeResourceRefs returns policy references to resource IDs relative to the branch the policy is loaded into
+func relativeResourceRefs(branch, kind string, ids []string) []policyRef {
+	refs := make([]policyRef, 0, len(ids))
+	for _, id := range ids {
+		refs = append(refs, policyRef{Kind: kind, Id: strings.TrimPrefix(id, branch+"/")})
+	}
+	return refs
+}
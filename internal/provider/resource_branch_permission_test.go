@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurBranchPermissionResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewConjurBranchPermissionResource().Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func testBranchPermissionModel(privileges ...string) *ConjurBranchPermissionResourceModel {
+	privilegeList, _ := types.ListValueFrom(context.Background(), types.StringType, privileges)
+	return &ConjurBranchPermissionResourceModel{
+		Role:        testPermissionRecord("group", "data", "payments-team"),
+		Branch:      types.StringValue("data/apps/payments"),
+		Kind:        types.StringValue("variable"),
+		Privileges:  privilegeList,
+		ResourceIDs: types.SetNull(types.StringType),
+	}
+}
+
+// testBranchResource returns a resource as listed by the API with the given role and privilege pairs
+func testBranchResource(id string, rolePrivileges ...string) map[string]interface{} {
+	conjurResource := testResourcePermissions(rolePrivileges...)
+	conjurResource["id"] = "myaccount:" + id
+	return conjurResource
+}
+
+func TestListBranchResources(t *testing.T) {
+	t.Run("Filters by branch", func(t *testing.T) {
+		// The search also matches annotations, so resources outside the branch can be returned
+		listing := []map[string]interface{}{
+			testBranchResource("variable:data/other/secret"),
+			testBranchResource("variable:data/apps/payments/db-password"),
+			testBranchResource("variable:data/apps/payments/nested/api-key"),
+			testBranchResource("variable:data/apps/payments-legacy/db-password"),
+		}
+
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "variable", Search: "data/apps/payments"}).Return(&conjurapi.ResourcesCount{Count: len(listing)}, nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Search: "data/apps/payments", Limit: len(listing)}).Return(listing, nil).Once()
+
+		resources, err := listBranchResources(mockV2, "variable", "/data/apps/payments/")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"data/apps/payments/db-password", "data/apps/payments/nested/api-key"}, conjurResourceIDs(resources))
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Root branch lists every resource of the kind", func(t *testing.T) {
+		listing := []map[string]interface{}{
+			testBranchResource("variable:top-level"),
+			testBranchResource("variable:data/apps/payments/db-password"),
+		}
+
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "variable"}).Return(&conjurapi.ResourcesCount{Count: len(listing)}, nil).Once()
+		mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Limit: len(listing)}).Return(listing, nil).Once()
+
+		resources, err := listBranchResources(mockV2, "variable", "root")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"data/apps/payments/db-password", "top-level"}, conjurResourceIDs(resources))
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("API error", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("ResourcesCount", mock.Anything).Return(nil, fmt.Errorf("403 Forbidden"))
+
+		_, err := listBranchResources(mockV2, "variable", "data/apps/payments")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestGenerateBranchPermissionPolicy(t *testing.T) {
+	ids := []string{"data/apps/payments/api-key", "data/apps/payments/db-password"}
+
+	t.Run("Permits privileges on every resource", func(t *testing.T) {
+		branch, permissionPolicy, err := generateBranchPermissionPolicy(testBranchPermissionModel("read", "execute"), ids, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", branch)
+		assert.Equal(t, "- !permit\n"+
+			"  role: [!group payments-team]\n"+
+			"  privileges: [read, execute]\n"+
+			"  resource: [!variable apps/payments/api-key, !variable apps/payments/db-password]\n", permissionPolicy)
+	})
+
+	t.Run("Removed privileges are denied on resources still in the branch", func(t *testing.T) {
+		previousIDs := []string{"data/apps/payments/api-key", "data/apps/payments/deleted"}
+
+		_, permissionPolicy, err := generateBranchPermissionPolicy(testBranchPermissionModel("read"), ids, testBranchPermissionModel("read", "execute"), previousIDs)
+
+		require.NoError(t, err)
+		assert.Equal(t, "- !permit\n"+
+			"  role: [!group payments-team]\n"+
+			"  privileges: [read]\n"+
+			"  resource: [!variable apps/payments/api-key, !variable apps/payments/db-password]\n"+
+			"- !deny\n"+
+			"  role: [!group payments-team]\n"+
+			"  privileges: [execute]\n"+
+			"  resource: [!variable apps/payments/api-key]\n", permissionPolicy)
+	})
+
+	t.Run("Empty branch", func(t *testing.T) {
+		branch, permissionPolicy, err := generateBranchPermissionPolicy(testBranchPermissionModel("read"), nil, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", branch)
+		assert.Empty(t, permissionPolicy)
+	})
+
+	t.Run("Deny policy", func(t *testing.T) {
+		_, permissionPolicy, err := generateBranchPermissionDenyPolicy(testBranchPermissionModel("read", "execute"), ids[:1])
+
+		require.NoError(t, err)
+		assert.Equal(t, "- !deny\n"+
+			"  role: [!group payments-team]\n"+
+			"  privileges: [read, execute]\n"+
+			"  resource: [!variable apps/payments/api-key]\n", permissionPolicy)
+	})
+}
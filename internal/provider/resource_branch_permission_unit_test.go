@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testBranchPermissionListing() []map[string]interface{} {
+	return []map[string]interface{}{
+		testBranchResource("variable:data/apps/payments/db-password",
+			"myaccount:group:data/payments-team", "read", "myaccount:group:data/payments-team", "execute"),
+		testBranchResource("variable:data/apps/payments/api-key",
+			"myaccount:group:data/payments-team", "read"),
+		testBranchResource("variable:data/apps/other/db-password",
+			"myaccount:group:data/payments-team", "read", "myaccount:group:data/payments-team", "execute"),
+	}
+}
+
+func mockBranchPermissionListing(mockV2 *mocks.MockClientV2, listing []map[string]interface{}) {
+	mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: len(listing)}, nil)
+	if len(listing) > 0 {
+		mockV2.On("Resources", mock.Anything).Return(listing, nil)
+	}
+}
+
+func testBranchPermissionState(ids ...string) *ConjurBranchPermissionResourceModel {
+	data := testBranchPermissionModel("read", "execute")
+	data.ResourceIDs, _ = types.SetValueFrom(context.Background(), types.StringType, ids)
+	return data
+}
+
+func TestBranchPermissionResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedIDs   []string
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "permits every resource in the branch",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockBranchPermissionListing(mockV2, testBranchPermissionListing())
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return contains(buf.String(), "resource: [!variable apps/payments/api-key, !variable apps/payments/db-password]")
+				})).Return(&conjurapi.PolicyResponse{}, nil).Once()
+			},
+			expectedIDs: []string{"data/apps/payments/api-key", "data/apps/payments/db-password"},
+		},
+		{
+			name: "empty branch doesn't load policy",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockBranchPermissionListing(mockV2, []map[string]interface{}{})
+			},
+			expectedIDs: []string{},
+		},
+		{
+			name: "API error listing resources",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourcesCount", mock.Anything).Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Unable to list resources",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurBranchPermissionResource{
+				client: mockV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getBranchPermissionTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getBranchPermissionTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			plan := testBranchPermissionModel("read", "execute")
+			plan.ResourceIDs = types.SetUnknown(types.StringType)
+			req.Plan.Set(ctx, plan)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result ConjurBranchPermissionResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, tt.expectedIDs, branchPermissionResourceIDs(ctx, result.ResourceIDs, &resp.Diagnostics))
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBranchPermissionResource_Read(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockBranchPermissionListing(mockV2, testBranchPermissionListing())
+
+	r := &ConjurBranchPermissionResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	req := resource.ReadRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+	}
+	req.State.Set(ctx, testBranchPermissionState("data/apps/payments/api-key", "data/apps/payments/db-password"))
+
+	r.Read(ctx, req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	var result ConjurBranchPermissionResourceModel
+	resp.State.Get(ctx, &result)
+	// api-key lost the execute privilege outside of Terraform
+	assert.Equal(t, []string{"data/apps/payments/db-password"}, branchPermissionResourceIDs(ctx, result.ResourceIDs, &resp.Diagnostics))
+	mockV2.AssertExpectations(t)
+}
+
+func TestBranchPermissionResource_ModifyPlan(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockBranchPermissionListing(mockV2, testBranchPermissionListing())
+	mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(&conjurapi.DryRunPolicyResponse{}, nil)
+
+	r := &ConjurBranchPermissionResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	testSchema := getBranchPermissionTestSchema()
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+		Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+	}
+	state := testBranchPermissionState("data/apps/payments/db-password")
+	req.State.Set(ctx, state)
+	req.Plan.Set(ctx, state)
+	req.Config = tfsdk.Config{Raw: req.Plan.Raw, Schema: testSchema}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+	r.ModifyPlan(ctx, req, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	var result ConjurBranchPermissionResourceModel
+	resp.Plan.Get(ctx, &result)
+	assert.Equal(t, []string{"data/apps/payments/api-key", "data/apps/payments/db-password"}, branchPermissionResourceIDs(ctx, result.ResourceIDs, &resp.Diagnostics))
+	mockV2.AssertExpectations(t)
+}
+
+func TestBranchPermissionResource_ReadThenModifyPlanListsOnce(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	listing := testBranchPermissionListing()
+	mockV2.On("ResourcesCount", mock.Anything).Return(&conjurapi.ResourcesCount{Count: len(listing)}, nil).Once()
+	mockV2.On("Resources", mock.Anything).Return(listing, nil).Once()
+	mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(&conjurapi.DryRunPolicyResponse{}, nil)
+
+	r := &ConjurBranchPermissionResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	testSchema := getBranchPermissionTestSchema()
+	readReq := resource.ReadRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+	}
+	readResp := &resource.ReadResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+	}
+	readReq.State.Set(ctx, testBranchPermissionState("data/apps/payments/db-password"))
+	r.Read(ctx, readReq, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "%+v", readResp.Diagnostics)
+
+	planReq := resource.ModifyPlanRequest{
+		State: readResp.State,
+		Plan:  tfsdk.Plan{Raw: readResp.State.Raw, Schema: testSchema},
+	}
+	planReq.Config = tfsdk.Config{Raw: planReq.Plan.Raw, Schema: testSchema}
+	planResp := &resource.ModifyPlanResponse{Plan: planReq.Plan}
+	r.ModifyPlan(ctx, planReq, planResp)
+	require.False(t, planResp.Diagnostics.HasError(), "%+v", planResp.Diagnostics)
+
+	mockV2.AssertExpectations(t)
+}
+
+func TestBranchPermissionResource_Update(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "!permit") && contains(buf.String(), "!deny") && contains(buf.String(), "privileges: [execute]")
+	})).Return(&conjurapi.PolicyResponse{}, nil).Once()
+
+	r := &ConjurBranchPermissionResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+	}
+	plan := testBranchPermissionState("data/apps/payments/api-key", "data/apps/payments/db-password")
+	plan.Privileges = testBranchPermissionModel("read").Privileges
+	req.Plan.Set(ctx, plan)
+	req.State.Set(ctx, testBranchPermissionState("data/apps/payments/db-password"))
+
+	r.Update(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockV2.AssertExpectations(t)
+}
+
+func TestBranchPermissionResource_Delete(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "!deny") && contains(buf.String(), "resource: [!variable apps/payments/db-password]")
+	})).Return(&conjurapi.PolicyResponse{}, nil).Once()
+
+	r := &ConjurBranchPermissionResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+	}
+	resp := &resource.DeleteResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getBranchPermissionTestSchema()},
+	}
+	req.State.Set(ctx, testBranchPermissionState("data/apps/payments/db-password"))
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockV2.AssertExpectations(t)
+}
+
+func getBranchPermissionTestSchema() schema.Schema {
+	r := &ConjurBranchPermissionResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
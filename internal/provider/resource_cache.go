@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"sync"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+)
+
+// sharedResourceCache shares the resources read during refresh between all resource instances of the provider process,
+// so that every permission on the same resource is derived from a single API call, and every branch is listed once
+// between refresh and plan.
+var sharedResourceCache = newResourceCache()
+
+// resourceCache caches resources returned by ClientV2.Resource, and branch listings, per client. Entries are dropped
+// once a policy has been loaded since they were fetched, because the policy may have changed the resources.
+type resourceCache struct {
+	mu      sync.Mutex
+	entries map[resourceCacheKey]*resourceCacheEntry
+}
+
+type resourceCacheKey struct {
+	client  api.ClientV2
+	id      string
+	listing bool
+}
+
+type resourceCacheEntry struct {
+	once       sync.Once
+	generation uint64
+	resource   map[string]interface{}
+	resources  []map[string]interface{}
+	err        error
+}
+
+func newResourceCache() *resourceCache {
+	return &resourceCache{entries: map[resourceCacheKey]*resourceCacheEntry{}}
+}
+
+// get returns the resource with the given `kind:id`, fetching it at most once for concurrent and later callers
+func (c *resourceCache) get(client api.ClientV2, resourceID string) (map[string]interface{}, error) {
+	entry := c.load(resourceCacheKey{client: client, id: resourceID}, func(entry *resourceCacheEntry) {
+		entry.resource, entry.err = client.Resource(resourceID)
+	})
+	return entry.resource, entry.err
+}
+
+// listBranch returns the resources of a kind in a branch, listing them at most once for concurrent and later callers
+func (c *resourceCache) listBranch(client api.ClientV2, kind, branch string) ([]map[string]interface{}, error) {
+	entry := c.load(resourceCacheKey{client: client, id: kind + ":" + branch, listing: true}, func(entry *resourceCacheEntry) {
+		entry.resources, entry.err = listBranchResources(client, kind, branch)
+	})
+	return entry.resources, entry.err
+}
+
+// load returns the current entry for a key, filling it with fetch the first time it's requested
+func (c *resourceCache) load(key resourceCacheKey, fetch func(*resourceCacheEntry)) *resourceCacheEntry {
+	generation := policy.Generation()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok || entry.generation != generation {
+		entry = &resourceCacheEntry{generation: generation}
+		c.entries[key] = entry
+	}
+	c.mu.Unlock()
+
+	entry.once.Do(func() {
+		fetch(entry)
+	})
+
+	// Errors are only shared with concurrent callers, later callers try again
+	if entry.err != nil {
+		c.mu.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mu.Unlock()
+	}
+	return entry
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResourceCache_Get(t *testing.T) {
+	t.Run("Concurrent callers share a single call", func(t *testing.T) {
+		cache := newResourceCache()
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("Resource", "variable:data/db-password").Return(testResourcePermissions(
+			"myaccount:host:data/app", "read"), nil).Once()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				conjurResource, err := cache.get(mockV2, "variable:data/db-password")
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"read"}, rolePrivilegesOnResource(conjurResource, "host", "data/app"))
+			}()
+		}
+		wg.Wait()
+
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Clients are cached separately", func(t *testing.T) {
+		cache := newResourceCache()
+		first := mocks.NewMockClientV2(t)
+		first.On("Resource", "variable:data/db-password").Return(testResourcePermissions(), nil).Once()
+		second := mocks.NewMockClientV2(t)
+		second.On("Resource", "variable:data/db-password").Return(nil, fmt.Errorf("403 Forbidden")).Once()
+
+		_, err := cache.get(first, "variable:data/db-password")
+		require.NoError(t, err)
+		_, err = cache.get(second, "variable:data/db-password")
+		assert.Error(t, err)
+	})
+
+	t.Run("Errors are not cached", func(t *testing.T) {
+		cache := newResourceCache()
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("Resource", "variable:data/db-password").Return(nil, fmt.Errorf("connection error")).Once()
+		mockV2.On("Resource", "variable:data/db-password").Return(testResourcePermissions(), nil).Once()
+
+		_, err := cache.get(mockV2, "variable:data/db-password")
+		assert.Error(t, err)
+		_, err = cache.get(mockV2, "variable:data/db-password")
+		assert.NoError(t, err)
+
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Policy loads invalidate the cache", func(t *testing.T) {
+		cache := newResourceCache()
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("Resource", "variable:data/db-password").Return(testResourcePermissions(), nil).Once()
+		mockV2.On("Resource", "variable:data/db-password").Return(testResourcePermissions(
+			"myaccount:host:data/app", "read"), nil).Once()
+		mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(&conjurapi.PolicyResponse{}, nil)
+
+		conjurResource, err := cache.get(mockV2, "variable:data/db-password")
+		require.NoError(t, err)
+		assert.Empty(t, rolePrivilegesOnResource(conjurResource, "host", "data/app"))
+
+		require.NoError(t, policy.ApplyPolicy(mockV2, "- !permit {}", "data"))
+
+		conjurResource, err = cache.get(mockV2, "variable:data/db-password")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"read"}, rolePrivilegesOnResource(conjurResource, "host", "data/app"))
+		mockV2.AssertExpectations(t)
+	})
+}
+
+func TestResourceCache_ListBranch(t *testing.T) {
+	cache := newResourceCache()
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "variable", Search: "data/apps"}).Return(&conjurapi.ResourcesCount{Count: 1}, nil).Once()
+	mockV2.On("Resources", &conjurapi.ResourceFilter{Kind: "variable", Search: "data/apps", Limit: 1}).Return([]map[string]interface{}{
+		{"id": "myaccount:variable:data/apps/db-password"},
+	}, nil).Once()
+	mockV2.On("ResourcesCount", &conjurapi.ResourceFilter{Kind: "host", Search: "data/apps"}).Return(&conjurapi.ResourcesCount{Count: 0}, nil).Once()
+
+	for i := 0; i < 2; i++ {
+		resources, err := cache.listBranch(mockV2, "variable", "data/apps")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"data/apps/db-password"}, conjurResourceIDs(resources))
+	}
+	resources, err := cache.listBranch(mockV2, "host", "data/apps")
+	require.NoError(t, err)
+	assert.Empty(t, resources)
+	mockV2.AssertExpectations(t)
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurCertificateResource{}
+	_ resource.ResourceWithConfigure      = &ConjurCertificateResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurCertificateResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurCertificateResource{}
+)
+
+// timeNow is swapped out in tests to check renewal windows
+var timeNow = time.Now
+
+func NewConjurCertificateResource() resource.Resource {
+	return &ConjurCertificateResource{}
+}
+
+// ConjurCertificateResource defines the resource implementation.
+type ConjurCertificateResource struct {
+	client certificateIssuer
+}
+
+// ConjurCertificateResourceModel describes the resource data model.
+type ConjurCertificateResourceModel struct {
+	certificateIssueRequestModel
+
+	EarlyRenewal    types.String   `tfsdk:"early_renewal"`
+	Certificate     types.String   `tfsdk:"certificate"`
+	Chain           []types.String `tfsdk:"chain"`
+	PrivateKey      types.String   `tfsdk:"private_key"`
+	NotAfter        types.String   `tfsdk:"not_after"`
+	ReadyForRenewal types.Bool     `tfsdk:"ready_for_renewal"`
+}
+
+func (r *ConjurCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate"
+}
+
+func (r *ConjurCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{stringplanmodifier.RequiresReplace()}
+	listRequiresReplace := []planmodifier.List{listplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Certificate issued by a Secrets Manager certificate issuer. Unlike the `conjur_certificate_issue` data source, the certificate is issued once " +
+			"and only re-issued when the subject or subject alternative names change, or when it enters its `early_renewal` window. " +
+			"The private key is stored in Terraform state, use the `conjur_certificate` ephemeral resource to avoid persisting it.",
+		Attributes: map[string]schema.Attribute{
+			"issuer_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the Secrets Manager issuer to use.",
+				PlanModifiers:       requiresReplace,
+			},
+			"common_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Common Name for the issued certificate.",
+				PlanModifiers:       requiresReplace,
+			},
+			"organization": schema.StringAttribute{Optional: true, PlanModifiers: requiresReplace},
+			"org_units":    schema.ListAttribute{ElementType: types.StringType, Optional: true, PlanModifiers: listRequiresReplace},
+			"locality":     schema.StringAttribute{Optional: true, PlanModifiers: requiresReplace},
+			"state":        schema.StringAttribute{Optional: true, PlanModifiers: requiresReplace},
+			"country":      schema.StringAttribute{Optional: true, PlanModifiers: requiresReplace},
+			"key_type":     schema.StringAttribute{Optional: true, MarkdownDescription: "Key type (e.g., RSA or ECDSA).", PlanModifiers: requiresReplace},
+			"ttl":          schema.StringAttribute{Optional: true, MarkdownDescription: "Time-to-live for the certificate (e.g., '24h').", PlanModifiers: requiresReplace},
+			"zone":         schema.StringAttribute{Optional: true, PlanModifiers: requiresReplace},
+			"dns_names":    schema.ListAttribute{ElementType: types.StringType, Optional: true, PlanModifiers: listRequiresReplace},
+			"ip_addresses": schema.ListAttribute{ElementType: types.StringType, Optional: true, PlanModifiers: listRequiresReplace},
+			"email_addresses": schema.ListAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				PlanModifiers: listRequiresReplace,
+			},
+			"uris": schema.ListAttribute{
+				ElementType:   types.StringType,
+				Optional:      true,
+				PlanModifiers: listRequiresReplace,
+			},
+			"early_renewal": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "How long before expiry the certificate is re-issued, as a duration string (e.g. '720h'). " +
+					"Without it the certificate is only re-issued once it has expired.",
+			},
+
+			// Outputs
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The issued certificate in PEM format.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"chain": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Certificate chain returned by the issuer.",
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+			},
+			"private_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Private key in PEM format.",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"not_after": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiry of the certificate (RFC 3339).",
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ready_for_renewal": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the certificate is within its renewal window and will be re-issued on the next apply.",
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *ConjurCertificateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurCertificateResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.EarlyRenewal.IsNull() || data.EarlyRenewal.IsUnknown() {
+		return
+	}
+	if d, err := time.ParseDuration(data.EarlyRenewal.ValueString()); err != nil || d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("early_renewal"),
+			"Invalid early_renewal",
+			fmt.Sprintf("early_renewal must be a non-negative duration (e.g. 720h), got %q", data.EarlyRenewal.ValueString()),
+		)
+	}
+}
+
+func (r *ConjurCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = &conjurAPIWrapper{client}
+}
+
+// ModifyPlan replaces the certificate once it is within its renewal window
+func (r *ConjurCertificateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ConjurCertificateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.EarlyRenewal.IsUnknown() {
+		return
+	}
+
+	ready, diags := certificateRenewalDue(state.NotAfter, plan.EarlyRenewal)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !ready {
+		return
+	}
+
+	tflog.Debug(ctx, "certificate is within its renewal window", map[string]interface{}{"not_after": state.NotAfter.ValueString()})
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("ready_for_renewal"))
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ready_for_renewal"), types.BoolUnknown())...)
+}
+
+func (r *ConjurCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Issuing certificate via Secrets Manager", map[string]interface{}{
+		"issuer_name": data.IssuerName.ValueString(),
+		"common_name": data.CommonName.ValueString(),
+	})
+
+	certResp, err := r.client.CertificateIssue(data.IssuerName.ValueString(), data.issue())
+	if err != nil {
+		resp.Diagnostics.AddError("Error issuing certificate", err.Error())
+		return
+	}
+
+	notAfter, err := certificateNotAfter(certResp.Certificate)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading issued certificate", fmt.Sprintf("Unable to read the expiry of the issued certificate: %s", err))
+		return
+	}
+
+	data.Certificate = types.StringValue(certResp.Certificate)
+	data.PrivateKey = types.StringValue(certResp.PrivateKey)
+	data.Chain = make([]types.String, len(certResp.Chain))
+	for i, c := range certResp.Chain {
+		data.Chain[i] = types.StringValue(c)
+	}
+	data.NotAfter = types.StringValue(notAfter.UTC().Format(time.RFC3339))
+
+	ready, diags := certificateRenewalDue(data.NotAfter, data.EarlyRenewal)
+	resp.Diagnostics.Append(diags...)
+	data.ReadyForRenewal = types.BoolValue(ready)
+
+	tflog.Trace(ctx, "created certificate resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read only re-evaluates the renewal window, issued certificates can't be read back from the API
+func (r *ConjurCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ConjurCertificateResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ready, diags := certificateRenewalDue(data.NotAfter, data.EarlyRenewal)
+	resp.Diagnostics.Append(diags...)
+	data.ReadyForRenewal = types.BoolValue(ready)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only handles changes to early_renewal, every other input re-issues the certificate
+func (r *ConjurCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ConjurCertificateResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ready, diags := certificateRenewalDue(data.NotAfter, data.EarlyRenewal)
+	resp.Diagnostics.Append(diags...)
+	data.ReadyForRenewal = types.BoolValue(ready)
+
+	tflog.Trace(ctx, "updated certificate resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the certificate from state, the issuer API doesn't support revoking certificates
+func (r *ConjurCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "deleted certificate resource")
+}
+
+// certificateRenewalDue reports whether a certificate with the given expiry is within its early renewal window
+func certificateRenewalDue(notAfter, earlyRenewal types.String) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if notAfter.IsNull() || notAfter.IsUnknown() {
+		return false, diags
+	}
+
+	expiry, err := time.Parse(time.RFC3339, notAfter.ValueString())
+	if err != nil {
+		diags.AddError("Invalid not_after", fmt.Sprintf("Unable to parse certificate expiry %q: %s", notAfter.ValueString(), err))
+		return false, diags
+	}
+
+	var window time.Duration
+	if !earlyRenewal.IsNull() && !earlyRenewal.IsUnknown() {
+		window, err = time.ParseDuration(earlyRenewal.ValueString())
+		if err != nil {
+			diags.AddError("Invalid early_renewal", fmt.Sprintf("Unable to parse early_renewal %q: %s", earlyRenewal.ValueString(), err))
+			return false, diags
+		}
+	}
+
+	return certificateReadyForRenewal(expiry, window, timeNow()), diags
+}
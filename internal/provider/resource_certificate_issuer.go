@@ -0,0 +1,395 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	issuerTypeAWS = "aws"
+
+	// maskedIssuerSecret is returned by the Issuers API in place of secret data values
+	maskedIssuerSecret = "*****"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurCertificateIssuerResource{}
+	_ resource.ResourceWithImportState    = &ConjurCertificateIssuerResource{}
+	_ resource.ResourceWithConfigure      = &ConjurCertificateIssuerResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurCertificateIssuerResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurCertificateIssuerResource{}
+)
+
+func NewConjurCertificateIssuerResource() resource.Resource {
+	return &ConjurCertificateIssuerResource{}
+}
+
+// ConjurCertificateIssuerResource defines the resource implementation.
+type ConjurCertificateIssuerResource struct {
+	client api.ClientV2
+}
+
+// ConjurCertificateIssuerResourceModel describes the resource data model.
+type ConjurCertificateIssuerResourceModel struct {
+	ID          types.String              `tfsdk:"id"`
+	Type        types.String              `tfsdk:"type"`
+	MaxTTL      types.Int64               `tfsdk:"max_ttl"`
+	AWS         *ConjurIssuerAWSDataModel `tfsdk:"aws"`
+	Data        map[string]string         `tfsdk:"data"`
+	KeepSecrets types.Bool                `tfsdk:"keep_secrets"`
+	CreatedAt   types.String              `tfsdk:"created_at"`
+	ModifiedAt  types.String              `tfsdk:"modified_at"`
+}
+
+// ConjurIssuerAWSDataModel describes the data of an `aws` issuer
+type ConjurIssuerAWSDataModel struct {
+	AccessKeyID     types.String `tfsdk:"access_key_id"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+}
+
+func (r *ConjurCertificateIssuerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_certificate_issuer"
+}
+
+func (r *ConjurCertificateIssuerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager issuer resource. Issuers hold the configuration used to issue certificates and dynamic secrets. " +
+			"`max_ttl` and the issuer data can be updated in place, changing the ID or type recreates the issuer.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issuer",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The issuer type (e.g. aws)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_ttl": schema.Int64Attribute{
+				MarkdownDescription: "The maximum TTL, in seconds, of the certificates or secrets created with the issuer",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"aws": schema.SingleNestedAttribute{
+				MarkdownDescription: "Issuer data for the `aws` issuer type",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"access_key_id": schema.StringAttribute{
+						MarkdownDescription: "AWS access key ID",
+						Required:            true,
+					},
+					"secret_access_key": schema.StringAttribute{
+						MarkdownDescription: "AWS secret access key. The API never returns this value, so changes made outside Terraform are not detected",
+						Required:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"data": schema.MapAttribute{
+				MarkdownDescription: "Issuer data for issuer types without a dedicated block",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"keep_secrets": schema.BoolAttribute{
+				MarkdownDescription: "Whether to keep the secrets created with the issuer when the issuer is deleted. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation time of the issuer",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified_at": schema.StringAttribute{
+				MarkdownDescription: "Last modification time of the issuer",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConjurCertificateIssuerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurCertificateIssuerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.ID, &resp.Diagnostics, "Issuer ID")
+	ValidateNonEmpty(data.Type, &resp.Diagnostics, "Issuer type")
+
+	if data.AWS != nil && data.Data != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data"), "Conflicting Issuer Data", "Only one of `aws` or `data` can be set.")
+	}
+	if data.Type.IsUnknown() {
+		return
+	}
+	if data.Type.ValueString() == issuerTypeAWS && data.Data != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data"), "Invalid Issuer Data", "Use the `aws` block to configure issuers of type aws.")
+	}
+	if data.Type.ValueString() != issuerTypeAWS && data.AWS != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("aws"), "Invalid Issuer Data", fmt.Sprintf("The `aws` block can't be used with issuer type %q.", data.Type.ValueString()))
+	}
+}
+
+func (r *ConjurCertificateIssuerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurCertificateIssuerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ConjurCertificateIssuerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Updating the issuer changes its modification time, which is otherwise kept from state
+	if issuerSettingsChanged(&plan, &state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("modified_at"), types.StringUnknown())...)
+	}
+}
+
+func (r *ConjurCertificateIssuerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurCertificateIssuerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issuer, err := r.client.CreateIssuer(conjurapi.Issuer{
+		ID:     data.ID.ValueString(),
+		Type:   data.Type.ValueString(),
+		MaxTTL: int(data.MaxTTL.ValueInt64()),
+		Data:   buildIssuerData(&data),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issuer %q, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	parseIssuerResponse(issuer, &data)
+
+	tflog.Trace(ctx, "created certificate issuer resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurCertificateIssuerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurCertificateIssuerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issuer, err := r.client.Issuer(data.ID.ValueString())
+	if isNotFoundErr(err) {
+		resp.Diagnostics.AddWarning("Issuer Not Found", fmt.Sprintf("The issuer %q was not found in Conjur and will be removed from the state.", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read issuer %q, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	parseIssuerResponse(issuer, &data)
+
+	tflog.Trace(ctx, "read certificate issuer resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurCertificateIssuerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state ConjurCertificateIssuerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// keep_secrets only applies when the issuer is deleted, so changing it alone doesn't update the issuer
+	if !issuerSettingsChanged(&data, &state) {
+		data.CreatedAt = state.CreatedAt
+		data.ModifiedAt = state.ModifiedAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	maxTTL := int(data.MaxTTL.ValueInt64())
+	issuer, err := r.client.UpdateIssuer(data.ID.ValueString(), conjurapi.IssuerUpdate{
+		MaxTTL: &maxTTL,
+		Data:   buildIssuerData(&data),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issuer %q, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	parseIssuerResponse(issuer, &data)
+
+	tflog.Trace(ctx, "updated certificate issuer resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurCertificateIssuerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurCertificateIssuerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIssuer(data.ID.ValueString(), data.KeepSecrets.ValueBool())
+	if err != nil && !isNotFoundErr(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issuer %q, got error: %s", data.ID.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted certificate issuer resource")
+}
+
+func (r *ConjurCertificateIssuerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected format: <issuer-id>")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("keep_secrets"), false)...)
+}
+
+// buildIssuerData maps the typed issuer data block (or the generic data map) to an API payload
+func buildIssuerData(data *ConjurCertificateIssuerResourceModel) map[string]interface{} {
+	payload := map[string]interface{}{}
+	if data.AWS != nil {
+		addIfNotNull(payload, "access_key_id", data.AWS.AccessKeyID)
+		addIfNotNull(payload, "secret_access_key", data.AWS.SecretAccessKey)
+	}
+	for k, v := range data.Data {
+		payload[k] = v
+	}
+	return payload
+}
+
+// issuerSettingsChanged reports whether the planned issuer differs from the state in the settings stored by the issuer
+func issuerSettingsChanged(plan, state *ConjurCertificateIssuerResourceModel) bool {
+	if !plan.MaxTTL.Equal(state.MaxTTL) || !maps.Equal(plan.Data, state.Data) {
+		return true
+	}
+	if plan.AWS == nil || state.AWS == nil {
+		return plan.AWS != state.AWS
+	}
+	return !plan.AWS.AccessKeyID.Equal(state.AWS.AccessKeyID) || !plan.AWS.SecretAccessKey.Equal(state.AWS.SecretAccessKey)
+}
+
+// parseIssuerResponse maps the API response to the resource model. Secret values are masked by the API,
+// so the values already in the model are kept for them.
+func parseIssuerResponse(issuer conjurapi.Issuer, data *ConjurCertificateIssuerResourceModel) {
+	data.ID = types.StringValue(issuer.ID)
+	data.Type = types.StringValue(issuer.Type)
+	data.MaxTTL = types.Int64Value(int64(issuer.MaxTTL))
+	data.CreatedAt = types.StringValue(issuer.CreatedAt)
+	data.ModifiedAt = types.StringValue(issuer.ModifiedAt)
+
+	if issuer.Type == issuerTypeAWS {
+		aws := &ConjurIssuerAWSDataModel{SecretAccessKey: types.StringNull()}
+		if data.AWS != nil {
+			aws.SecretAccessKey = data.AWS.SecretAccessKey
+		}
+		aws.AccessKeyID = stringFromMap(issuer.Data, "access_key_id")
+		if secret, ok := issuer.Data["secret_access_key"].(string); ok && secret != maskedIssuerSecret {
+			aws.SecretAccessKey = types.StringValue(secret)
+		}
+		data.AWS = aws
+		data.Data = nil
+		return
+	}
+
+	if len(issuer.Data) == 0 {
+		data.Data = nil
+		return
+	}
+	previous := data.Data
+	data.Data = make(map[string]string, len(issuer.Data))
+	for k, v := range issuer.Data {
+		value := issuerDataString(v)
+		if value == maskedIssuerSecret {
+			if prev, ok := previous[k]; ok {
+				value = prev
+			}
+		}
+		data.Data[k] = value
+	}
+}
+
+// issuerDataString converts an issuer data value to its string form, encoding non-string values as JSON
+func issuerDataString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(raw)
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurCertificateIssuerResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := NewConjurCertificateIssuerResource()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	ds.Schema(ctx, schemaRequest, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestBuildIssuerData(t *testing.T) {
+	t.Run("aws issuer", func(t *testing.T) {
+		payload := buildIssuerData(&ConjurCertificateIssuerResourceModel{
+			Type: types.StringValue("aws"),
+			AWS: &ConjurIssuerAWSDataModel{
+				AccessKeyID:     types.StringValue("AKIAEXAMPLE"),
+				SecretAccessKey: types.StringValue("secret"),
+			},
+		})
+
+		assert.Equal(t, map[string]interface{}{
+			"access_key_id":     "AKIAEXAMPLE",
+			"secret_access_key": "secret",
+		}, payload)
+	})
+
+	t.Run("generic issuer data", func(t *testing.T) {
+		payload := buildIssuerData(&ConjurCertificateIssuerResourceModel{
+			Type: types.StringValue("venafi"),
+			Data: map[string]string{"zone": "Default", "url": "https://venafi.example.com"},
+		})
+
+		assert.Equal(t, map[string]interface{}{
+			"zone": "Default",
+			"url":  "https://venafi.example.com",
+		}, payload)
+	})
+}
+
+func TestParseIssuerResponse(t *testing.T) {
+	t.Run("masked aws secret keeps the configured value", func(t *testing.T) {
+		data := &ConjurCertificateIssuerResourceModel{
+			AWS: &ConjurIssuerAWSDataModel{
+				AccessKeyID:     types.StringValue("AKIAOLD"),
+				SecretAccessKey: types.StringValue("secret"),
+			},
+		}
+
+		parseIssuerResponse(conjurapi.Issuer{
+			ID:         "aws-issuer",
+			Type:       "aws",
+			MaxTTL:     3600,
+			Data:       map[string]interface{}{"access_key_id": "AKIANEW", "secret_access_key": maskedIssuerSecret},
+			CreatedAt:  "2026-01-01T00:00:00Z",
+			ModifiedAt: "2026-01-02T00:00:00Z",
+		}, data)
+
+		require.NotNil(t, data.AWS)
+		assert.Equal(t, "aws-issuer", data.ID.ValueString())
+		assert.Equal(t, int64(3600), data.MaxTTL.ValueInt64())
+		assert.Equal(t, "AKIANEW", data.AWS.AccessKeyID.ValueString())
+		assert.Equal(t, "secret", data.AWS.SecretAccessKey.ValueString())
+		assert.Nil(t, data.Data)
+		assert.Equal(t, "2026-01-02T00:00:00Z", data.ModifiedAt.ValueString())
+	})
+
+	t.Run("imported aws issuer has no secret", func(t *testing.T) {
+		data := &ConjurCertificateIssuerResourceModel{}
+
+		parseIssuerResponse(conjurapi.Issuer{
+			ID:   "aws-issuer",
+			Type: "aws",
+			Data: map[string]interface{}{"access_key_id": "AKIA", "secret_access_key": maskedIssuerSecret},
+		}, data)
+
+		require.NotNil(t, data.AWS)
+		assert.True(t, data.AWS.SecretAccessKey.IsNull())
+	})
+
+	t.Run("generic issuer data", func(t *testing.T) {
+		data := &ConjurCertificateIssuerResourceModel{
+			Data: map[string]string{"api_key": "key"},
+		}
+
+		parseIssuerResponse(conjurapi.Issuer{
+			ID:   "other-issuer",
+			Type: "other",
+			Data: map[string]interface{}{"api_key": maskedIssuerSecret, "retries": float64(3), "zone": "Default"},
+		}, data)
+
+		assert.Nil(t, data.AWS)
+		assert.Equal(t, map[string]string{"api_key": "key", "retries": "3", "zone": "Default"}, data.Data)
+	})
+}
@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func testAWSIssuerModel() ConjurCertificateIssuerResourceModel {
+	return ConjurCertificateIssuerResourceModel{
+		ID:     types.StringValue("aws-issuer"),
+		Type:   types.StringValue("aws"),
+		MaxTTL: types.Int64Value(3600),
+		AWS: &ConjurIssuerAWSDataModel{
+			AccessKeyID:     types.StringValue("AKIAEXAMPLE"),
+			SecretAccessKey: types.StringValue("secret"),
+		},
+		KeepSecrets: types.BoolValue(false),
+		CreatedAt:   types.StringUnknown(),
+		ModifiedAt:  types.StringUnknown(),
+	}
+}
+
+// testAWSIssuerState returns the state of the issuer created from testAWSIssuerModel
+func testAWSIssuerState() *ConjurCertificateIssuerResourceModel {
+	state := testAWSIssuerModel()
+	state.CreatedAt = types.StringValue("2026-01-01T00:00:00Z")
+	state.ModifiedAt = types.StringValue("2026-01-01T00:00:00Z")
+	return &state
+}
+
+func testAWSIssuerResponse(maxTTL int) conjurapi.Issuer {
+	return conjurapi.Issuer{
+		ID:         "aws-issuer",
+		Type:       "aws",
+		MaxTTL:     maxTTL,
+		Data:       map[string]interface{}{"access_key_id": "AKIAEXAMPLE", "secret_access_key": maskedIssuerSecret},
+		CreatedAt:  "2026-01-01T00:00:00Z",
+		ModifiedAt: "2026-01-01T00:00:00Z",
+	}
+}
+
+func TestCertificateIssuerResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful issuer creation",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateIssuer", mock.MatchedBy(func(issuer conjurapi.Issuer) bool {
+					return issuer.ID == "aws-issuer" && issuer.Type == "aws" && issuer.MaxTTL == 3600 &&
+						issuer.Data["secret_access_key"] == "secret"
+				})).Return(testAWSIssuerResponse(3600), nil)
+			},
+		},
+		{
+			name: "API error during creation",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("CreateIssuer", mock.Anything).Return(conjurapi.Issuer{}, fmt.Errorf("409 Conflict"))
+			},
+			expectedError: true,
+			errorContains: "Unable to create issuer",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurCertificateIssuerResource{
+				client: mockV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			}
+
+			ctx := context.Background()
+			data := testAWSIssuerModel()
+			req.Plan.Set(ctx, &data)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurCertificateIssuerResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, "secret", result.AWS.SecretAccessKey.ValueString())
+				assert.Equal(t, "2026-01-01T00:00:00Z", result.CreatedAt.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCertificateIssuerResource_Read(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*mocks.MockClientV2)
+		expectedError  bool
+		shouldRemove   bool
+		expectedMaxTTL int64
+	}{
+		{
+			name: "drift in max_ttl is detected",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Issuer", "aws-issuer").Return(testAWSIssuerResponse(7200), nil)
+			},
+			expectedMaxTTL: 7200,
+		},
+		{
+			name: "issuer removed - removes from state",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Issuer", "aws-issuer").Return(conjurapi.Issuer{}, fmt.Errorf("404 Not Found"))
+			},
+			shouldRemove: true,
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Issuer", "aws-issuer").Return(conjurapi.Issuer{}, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurCertificateIssuerResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			}
+
+			ctx := context.Background()
+			data := testAWSIssuerModel()
+			req.State.Set(ctx, &data)
+
+			r.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurCertificateIssuerResourceModel
+				diag := resp.State.Get(ctx, &result)
+				if tt.shouldRemove {
+					assert.True(t, diag.HasError() || result.ID.IsNull())
+				} else {
+					assert.Equal(t, tt.expectedMaxTTL, result.MaxTTL.ValueInt64())
+					assert.Equal(t, "secret", result.AWS.SecretAccessKey.ValueString())
+				}
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCertificateIssuerResource_Update(t *testing.T) {
+	t.Run("Settings changes update the issuer", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("UpdateIssuer", "aws-issuer", mock.MatchedBy(func(update conjurapi.IssuerUpdate) bool {
+			return update.MaxTTL != nil && *update.MaxTTL == 7200 && update.Data["access_key_id"] == "AKIAEXAMPLE"
+		})).Return(testAWSIssuerResponse(7200), nil)
+
+		r := &ConjurCertificateIssuerResource{
+			client: mockV2,
+		}
+
+		ctx := context.Background()
+		data := testAWSIssuerModel()
+		data.MaxTTL = types.Int64Value(7200)
+		req := resource.UpdateRequest{
+			Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+		}
+		resp := &resource.UpdateResponse{
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+		}
+		req.Plan.Set(ctx, &data)
+		req.State.Set(ctx, testAWSIssuerState())
+
+		r.Update(ctx, req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		var result ConjurCertificateIssuerResourceModel
+		resp.State.Get(ctx, &result)
+		assert.Equal(t, int64(7200), result.MaxTTL.ValueInt64())
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Changing only keep_secrets doesn't call the API", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+
+		r := &ConjurCertificateIssuerResource{
+			client: mockV2,
+		}
+
+		ctx := context.Background()
+		data := testAWSIssuerModel()
+		data.KeepSecrets = types.BoolValue(true)
+		data.CreatedAt = types.StringValue("2026-01-01T00:00:00Z")
+		req := resource.UpdateRequest{
+			Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+		}
+		resp := &resource.UpdateResponse{
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+		}
+		req.Plan.Set(ctx, &data)
+		req.State.Set(ctx, testAWSIssuerState())
+
+		r.Update(ctx, req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+		var result ConjurCertificateIssuerResourceModel
+		resp.State.Get(ctx, &result)
+		assert.True(t, result.KeepSecrets.ValueBool())
+		assert.Equal(t, "2026-01-01T00:00:00Z", result.ModifiedAt.ValueString())
+		mockV2.AssertExpectations(t)
+	})
+}
+
+func TestCertificateIssuerResource_ModifyPlan(t *testing.T) {
+	tests := []struct {
+		name            string
+		plan            func(*ConjurCertificateIssuerResourceModel)
+		expectedUnknown bool
+	}{
+		{
+			name: "max_ttl change marks modified_at unknown",
+			plan: func(data *ConjurCertificateIssuerResourceModel) {
+				data.MaxTTL = types.Int64Value(7200)
+			},
+			expectedUnknown: true,
+		},
+		{
+			name: "keep_secrets change keeps modified_at",
+			plan: func(data *ConjurCertificateIssuerResourceModel) {
+				data.KeepSecrets = types.BoolValue(true)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &ConjurCertificateIssuerResource{}
+
+			ctx := context.Background()
+			testSchema := getCertificateIssuerTestSchema()
+			state := testAWSIssuerState()
+			plan := *state
+			tt.plan(&plan)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+				Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: testSchema},
+			}
+			req.State.Set(ctx, state)
+			req.Plan.Set(ctx, &plan)
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			var result ConjurCertificateIssuerResourceModel
+			resp.Plan.Get(ctx, &result)
+			assert.Equal(t, tt.expectedUnknown, result.ModifiedAt.IsUnknown())
+		})
+	}
+}
+
+func TestCertificateIssuerResource_Delete(t *testing.T) {
+	for _, keepSecrets := range []bool{false, true} {
+		t.Run(fmt.Sprintf("keep_secrets=%t", keepSecrets), func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			mockV2.On("DeleteIssuer", "aws-issuer", keepSecrets).Return(nil)
+
+			r := &ConjurCertificateIssuerResource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			data := testAWSIssuerModel()
+			data.KeepSecrets = types.BoolValue(keepSecrets)
+			req := resource.DeleteRequest{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			}
+			resp := &resource.DeleteResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getCertificateIssuerTestSchema()},
+			}
+			req.State.Set(ctx, &data)
+
+			r.Delete(ctx, req, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getCertificateIssuerTestSchema() schema.Schema {
+	r := &ConjurCertificateIssuerResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurCertificateResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := NewConjurCertificateResource()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	ds.Schema(ctx, schemaRequest, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestCertificateIssueRequestModel_issue(t *testing.T) {
+	issue := certificateIssueRequestModel{
+		IssuerName:     types.StringValue("my-issuer"),
+		CommonName:     types.StringValue("app.example.com"),
+		Organization:   types.StringValue("Example Corp"),
+		OrgUnits:       []types.String{types.StringValue("Payments")},
+		KeyType:        types.StringValue("RSA"),
+		TTL:            types.StringValue("24h"),
+		DNSNames:       []types.String{types.StringValue("app.example.com"), types.StringValue("www.example.com")},
+		IPAddresses:    []types.String{types.StringValue("10.0.0.1")},
+		EmailAddresses: []types.String{types.StringValue("ops@example.com")},
+		Uris:           []types.String{types.StringValue("spiffe://example.com/app")},
+	}.issue()
+
+	assert.Equal(t, "app.example.com", issue.Subject.CommonName)
+	assert.Equal(t, "Example Corp", issue.Subject.Organization)
+	assert.Equal(t, []string{"Payments"}, issue.Subject.OrgUnits)
+	assert.Equal(t, "RSA", issue.KeyType)
+	assert.Equal(t, "24h", issue.TTL)
+	assert.Equal(t, []string{"app.example.com", "www.example.com"}, issue.AltNames.DNSNames)
+	assert.Equal(t, []string{"10.0.0.1"}, issue.AltNames.IPAddresses)
+	assert.Equal(t, []string{"ops@example.com"}, issue.AltNames.EMailAddresses)
+	assert.Equal(t, []string{"spiffe://example.com/app"}, issue.AltNames.Uris)
+}
+
+func TestCertificateNotAfter(t *testing.T) {
+	notAfter := time.Date(2027, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	parsed, err := certificateNotAfter(testCertificatePEM(t, notAfter))
+	require.NoError(t, err)
+	assert.True(t, notAfter.Equal(parsed))
+
+	_, err = certificateNotAfter("not a certificate")
+	assert.Error(t, err)
+
+	_, err = certificateNotAfter("-----BEGIN CERTIFICATE-----\naW52YWxpZA==\n-----END CERTIFICATE-----")
+	assert.Error(t, err)
+}
+
+func TestCertificateRenewalDue(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	originalTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = originalTimeNow })
+
+	tests := []struct {
+		name          string
+		notAfter      types.String
+		earlyRenewal  types.String
+		expectedReady bool
+		expectedError bool
+	}{
+		{
+			name:         "not yet in renewal window",
+			notAfter:     types.StringValue("2026-08-01T00:00:00Z"),
+			earlyRenewal: types.StringValue("720h"),
+		},
+		{
+			name:          "within renewal window",
+			notAfter:      types.StringValue("2026-06-15T00:00:00Z"),
+			earlyRenewal:  types.StringValue("720h"),
+			expectedReady: true,
+		},
+		{
+			name:         "no early renewal before expiry",
+			notAfter:     types.StringValue("2026-06-15T00:00:00Z"),
+			earlyRenewal: types.StringNull(),
+		},
+		{
+			name:          "no early renewal after expiry",
+			notAfter:      types.StringValue("2026-05-01T00:00:00Z"),
+			earlyRenewal:  types.StringNull(),
+			expectedReady: true,
+		},
+		{
+			name:         "unknown expiry",
+			notAfter:     types.StringUnknown(),
+			earlyRenewal: types.StringValue("720h"),
+		},
+		{
+			name:          "invalid early renewal",
+			notAfter:      types.StringValue("2026-08-01T00:00:00Z"),
+			earlyRenewal:  types.StringValue("a month"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, diags := certificateRenewalDue(tt.notAfter, tt.earlyRenewal)
+			assert.Equal(t, tt.expectedError, diags.HasError())
+			assert.Equal(t, tt.expectedReady, ready)
+		})
+	}
+}
+
+// testCertificatePEM creates a self-signed PEM encoded certificate expiring at notAfter
+func testCertificatePEM(t *testing.T, notAfter time.Time) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "app.example.com"},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
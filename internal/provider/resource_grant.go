@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurGrantResource{}
+	_ resource.ResourceWithConfigure      = &ConjurGrantResource{}
+	_ resource.ResourceWithImportState    = &ConjurGrantResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurGrantResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurGrantResource{}
+)
+
+// grantRoleKinds are the record kinds that can be granted or receive a grant
+var grantRoleKinds = []string{"user", "host", "group", "layer", "policy"}
+
+func NewConjurGrantResource() resource.Resource {
+	return &ConjurGrantResource{}
+}
+
+// ConjurGrantResource defines the resource implementation.
+type ConjurGrantResource struct {
+	client api.ClientV2
+}
+
+// ConjurGrantResourceModel describes the resource data model.
+type ConjurGrantResourceModel struct {
+	Role        RoleModel  `tfsdk:"role"`
+	Member      RoleModel  `tfsdk:"member"`
+	AdminOption types.Bool `tfsdk:"admin_option"`
+}
+
+func (r *ConjurGrantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_grant"
+}
+
+func (r *ConjurGrantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager grant resource. This resource grants a role to a member using a `!grant` policy statement and revokes it with `!revoke` on destroy. " +
+			"Unlike `conjur_membership` any role kind can be granted, including layers and policies, optionally with the admin option.",
+
+		Attributes: map[string]schema.Attribute{
+			"role": schema.SingleNestedAttribute{
+				MarkdownDescription: "The role being granted",
+				Required:            true,
+				Attributes:          grantRoleAttributes("role"),
+			},
+			"member": schema.SingleNestedAttribute{
+				MarkdownDescription: "The role receiving the grant",
+				Required:            true,
+				Attributes:          grantRoleAttributes("member"),
+			},
+			"admin_option": schema.BoolAttribute{
+				MarkdownDescription: "Whether the member can grant the role to others. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// grantRoleAttributes returns the attributes of the nested role and member blocks
+func grantRoleAttributes(name string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the %s", name),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"kind": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The kind of the %s: `user`, `host`, `group`, `layer` or `policy`", name),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"branch": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The policy branch of the %s", name),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+func (r *ConjurGrantResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurGrantResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate role
+	ValidateNonEmpty(data.Role.Name, &resp.Diagnostics, "Role name")
+	ValidateContainedIn(data.Role.Kind, &resp.Diagnostics, "Role kind", grantRoleKinds, false)
+	ValidateBranch(data.Role.Branch, &resp.Diagnostics, "role branch")
+
+	// Validate member
+	ValidateNonEmpty(data.Member.Name, &resp.Diagnostics, "Member name")
+	ValidateContainedIn(data.Member.Kind, &resp.Diagnostics, "Member kind", grantRoleKinds, false)
+	ValidateBranch(data.Member.Branch, &resp.Diagnostics, "member branch")
+}
+
+func (r *ConjurGrantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurGrantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurGrantResourceModel
+	var branch, grantPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branch, grantPolicy, err = r.generateRevokePolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branch, grantPolicy, err = r.generateGrantPolicy(&data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate grant policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, grantPolicy, branch, &resp.Diagnostics)
+}
+
+func (r *ConjurGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurGrantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, grantPolicy, err := r.generateGrantPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate grant policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, grantPolicy, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply grant policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created grant resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurGrantResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleID := fmt.Sprintf("%s:%s", data.Role.Kind.ValueString(), conjurRecordID(data.Role.Kind.ValueString(), data.Role.Branch.ValueString(), data.Role.Name.ValueString()))
+	members, err := r.client.RoleMembers(roleID)
+	if isNotFoundErr(err) {
+		resp.Diagnostics.AddWarning("Role Not Found", fmt.Sprintf("The role %q was not found in Conjur and the grant will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the role exists and can be managed by the provider identity.", roleID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Conjur grant",
+			fmt.Sprintf("Unable to read members of role %q: %s", roleID, err),
+		)
+		return
+	}
+
+	memberID := conjurRecordID(data.Member.Kind.ValueString(), data.Member.Branch.ValueString(), data.Member.Name.ValueString())
+	adminOption, found := findRoleMember(members, data.Member.Kind.ValueString(), memberID)
+	if !found {
+		resp.Diagnostics.AddWarning("Grant Not Found", fmt.Sprintf("The %s %q is no longer a member of %q and the grant will be removed from the state.", data.Member.Kind.ValueString(), memberID, roleID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.AdminOption = types.BoolValue(adminOption)
+
+	tflog.Trace(ctx, "read grant resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurGrantResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes are marked RequiresReplace, so this should never be called
+	resp.Diagnostics.AddError("Update Not Supported", "This resource does not support in-place updates. Please recreate the resource to apply changes.")
+}
+
+func (r *ConjurGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurGrantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, revokePolicy, err := r.generateRevokePolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Revoke Policy", fmt.Sprintf("Could not generate grant revoke policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, revokePolicy, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Revoke Policy", fmt.Sprintf("Could not apply grant revoke policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted grant resource")
+}
+
+func (r *ConjurGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+	if len(parts) != 2 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected format: kind/branch/role:kind/branch/member",
+		)
+		return
+	}
+
+	for i, attribute := range []string{"role", "member"} {
+		kind, branch, name, err := splitConjurID(parts[i])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Import Identifier",
+				fmt.Sprintf("Error parsing %s identifier: %s", attribute, err),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute).AtName("name"), name)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute).AtName("kind"), kind)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute).AtName("branch"), branch)...)
+	}
+}
+
+// generateGrantPolicy creates a policy granting the role to the member, loaded into their shared branch
+func (r *ConjurGrantResource) generateGrantPolicy(data *ConjurGrantResourceModel) (string, string, error) {
+	branch, role, member, err := grantPolicyRefs(data)
+	if err != nil {
+		return "", "", err
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{
+		policyGrant{
+			Role: role,
+			Member: policyMember{
+				Role:  member,
+				Admin: data.AdminOption.ValueBool(),
+			},
+		},
+	}
+
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal policy to YAML: %w", err)
+	}
+	return branch, string(yamlBytes), nil
+}
+
+// generateRevokePolicy creates a policy revoking the role from the member
+func (r *ConjurGrantResource) generateRevokePolicy(data *ConjurGrantResourceModel) (string, string, error) {
+	branch, role, member, err := grantPolicyRefs(data)
+	if err != nil {
+		return "", "", err
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{
+		policyRevoke{
+			Role:   role,
+			Member: member,
+		},
+	}
+
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal revoke policy to YAML: %w", err)
+	}
+	return branch, string(yamlBytes), nil
+}
+
+// grantPolicyRefs returns the branch to load a grant into and the role and member references relative to it
+func grantPolicyRefs(data *ConjurGrantResourceModel) (string, conjurpolicy.ResourceRef, conjurpolicy.ResourceRef, error) {
+	roleKind, err := conjurpolicy.KindString(data.Role.Kind.ValueString())
+	if err != nil {
+		return "", conjurpolicy.ResourceRef{}, conjurpolicy.ResourceRef{}, fmt.Errorf("invalid role kind: %w", err)
+	}
+	memberKind, err := conjurpolicy.KindString(data.Member.Kind.ValueString())
+	if err != nil {
+		return "", conjurpolicy.ResourceRef{}, conjurpolicy.ResourceRef{}, fmt.Errorf("invalid member kind: %w", err)
+	}
+
+	branch, roleID, memberID := relativePolicyIDs(
+		data.Role.Branch.ValueString(), data.Role.Name.ValueString(),
+		data.Member.Branch.ValueString(), data.Member.Name.ValueString(),
+	)
+
+	return branch,
+		conjurpolicy.ResourceRef{Kind: roleKind, Id: roleID},
+		conjurpolicy.ResourceRef{Kind: memberKind, Id: memberID},
+		nil
+}
+
+// findRoleMember looks up a member in the response of a role members request, returning its admin option.
+// Members holding ownership of the role aren't granted through policy, so they are skipped.
+func findRoleMember(members []map[string]interface{}, kind, id string) (adminOption bool, found bool) {
+	for _, m := range members {
+		if ownership, _ := m["ownership"].(bool); ownership {
+			continue
+		}
+		member, _ := m["member"].(string)
+		memberKind, memberID, err := splitFullyQualifiedID(member)
+		if err != nil || memberKind != kind || memberID != id {
+			continue
+		}
+		adminOption, _ = m["admin_option"].(bool)
+		return adminOption, true
+	}
+	return false, false
+}
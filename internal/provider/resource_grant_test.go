@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConjurGrantResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewConjurGrantResource().Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func testGrantModel(adminOption bool) *ConjurGrantResourceModel {
+	return &ConjurGrantResourceModel{
+		Role: RoleModel{
+			Name:   types.StringValue("app-layer"),
+			Kind:   types.StringValue("layer"),
+			Branch: types.StringValue("data/apps"),
+		},
+		Member: RoleModel{
+			Name:   types.StringValue("admins"),
+			Kind:   types.StringValue("group"),
+			Branch: types.StringValue("data"),
+		},
+		AdminOption: types.BoolValue(adminOption),
+	}
+}
+
+func TestConjurGrantResource_generateGrantPolicy(t *testing.T) {
+	r := &ConjurGrantResource{}
+
+	t.Run("Without admin option", func(t *testing.T) {
+		branch, grantPolicy, err := r.generateGrantPolicy(testGrantModel(false))
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", branch)
+		assert.Equal(t, "- !grant\n  role: !layer apps/app-layer\n  member: !group admins\n", grantPolicy)
+	})
+
+	t.Run("With admin option", func(t *testing.T) {
+		branch, grantPolicy, err := r.generateGrantPolicy(testGrantModel(true))
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", branch)
+		expected := `- !grant
+  role: !layer apps/app-layer
+  member: !member
+    role: !group admins
+    admin: true
+`
+		assert.Equal(t, expected, grantPolicy)
+	})
+
+	t.Run("Invalid kind", func(t *testing.T) {
+		data := testGrantModel(false)
+		data.Role.Kind = types.StringValue("webservice")
+
+		_, _, err := r.generateGrantPolicy(data)
+		assert.ErrorContains(t, err, "invalid role kind")
+	})
+}
+
+func TestConjurGrantResource_generateRevokePolicy(t *testing.T) {
+	r := &ConjurGrantResource{}
+
+	branch, revokePolicy, err := r.generateRevokePolicy(testGrantModel(true))
+
+	require.NoError(t, err)
+	assert.Equal(t, "data", branch)
+	assert.Equal(t, "- !revoke\n  role: !layer apps/app-layer\n  member: !group admins\n", revokePolicy)
+}
+
+// TestGenerateGrantPolicy_YAMLInjection tests that user input cannot inject additional YAML statements
+func TestGenerateGrantPolicy_YAMLInjection(t *testing.T) {
+	r := &ConjurGrantResource{}
+
+	data := testGrantModel(false)
+	data.Member.Name = types.StringValue("admins\n- !delete\n  record: !variable injected")
+
+	_, grantPolicy, err := r.generateGrantPolicy(data)
+	require.NoError(t, err)
+
+	var policyStatements conjurpolicy.PolicyStatements
+	err = yaml.Unmarshal([]byte(grantPolicy), &policyStatements)
+	require.NoError(t, err, "Policy should be valid YAML. Policy: %s", grantPolicy)
+	require.Len(t, policyStatements, 1, "Policy: %s", grantPolicy)
+
+	grant, ok := policyStatements[0].(conjurpolicy.Grant)
+	require.True(t, ok, "Statement should be a Grant statement. Policy: %s", grantPolicy)
+	assert.Equal(t, data.Member.Name.ValueString(), grant.Member.Id)
+}
+
+func TestConjurRecordID(t *testing.T) {
+	tests := []struct {
+		kind     string
+		branch   string
+		name     string
+		expected string
+	}{
+		{kind: "group", branch: "data/apps", name: "admins", expected: "data/apps/admins"},
+		{kind: "policy", branch: "root", name: "data", expected: "data"},
+		{kind: "user", branch: "data/apps", name: "alice", expected: "alice@data-apps"},
+		{kind: "user", branch: "root", name: "admin", expected: "admin"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, conjurRecordID(tt.kind, tt.branch, tt.name))
+		})
+	}
+}
+
+func TestFindRoleMember(t *testing.T) {
+	members := []map[string]interface{}{
+		{"role": "myaccount:layer:data/apps/app-layer", "member": "myaccount:policy:data/apps", "admin_option": true, "ownership": true},
+		{"role": "myaccount:layer:data/apps/app-layer", "member": "myaccount:group:data/admins", "admin_option": false},
+		{"role": "myaccount:layer:data/apps/app-layer", "member": "myaccount:host:data/apps/admins", "admin_option": true},
+		{"role": "myaccount:layer:data/apps/app-layer", "member": "myaccount:user:alice", "admin_option": true, "ownership": true},
+	}
+
+	adminOption, found := findRoleMember(members, "group", "data/admins")
+	assert.True(t, found)
+	assert.False(t, adminOption)
+
+	adminOption, found = findRoleMember(members, "host", "data/apps/admins")
+	assert.True(t, found)
+	assert.True(t, adminOption)
+
+	_, found = findRoleMember(members, "group", "data/apps/admins")
+	assert.False(t, found)
+
+	// An owner is a member of the role without being granted it
+	_, found = findRoleMember(members, "user", "alice")
+	assert.False(t, found)
+}
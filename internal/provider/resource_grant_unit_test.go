@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrantResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful grant",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return contains(buf.String(), "!grant") && contains(buf.String(), "admin: true")
+				})).Return(&conjurapi.PolicyResponse{}, nil)
+			},
+		},
+		{
+			name: "API error during grant",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(
+					nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Could not apply grant policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurGrantResource{
+				client: mockV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGrantTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGrantTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.Plan.Set(ctx, testGrantModel(true))
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGrantResource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		members       []map[string]interface{}
+		err           error
+		expectedError bool
+		expectRemoved bool
+		expectAdmin   bool
+	}{
+		{
+			name: "grant exists with admin option",
+			members: []map[string]interface{}{
+				{"member": "myaccount:policy:data/apps", "admin_option": true, "ownership": true},
+				{"member": "myaccount:group:data/admins", "admin_option": true},
+			},
+			expectAdmin: true,
+		},
+		{
+			name: "grant exists without admin option",
+			members: []map[string]interface{}{
+				{"member": "myaccount:group:data/admins", "admin_option": false},
+			},
+		},
+		{
+			name: "grant revoked outside of Terraform",
+			members: []map[string]interface{}{
+				{"member": "myaccount:policy:data/apps", "admin_option": true, "ownership": true},
+			},
+			expectRemoved: true,
+		},
+		{
+			name:          "role not found",
+			err:           fmt.Errorf("404 Not Found"),
+			expectRemoved: true,
+		},
+		{
+			name:          "API error",
+			err:           fmt.Errorf("connection error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			mockV2.On("RoleMembers", "layer:data/apps/app-layer").Return(tt.members, tt.err)
+
+			r := &ConjurGrantResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGrantTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGrantTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, testGrantModel(false))
+
+			r.Read(ctx, req, resp)
+
+			assert.Equal(t, tt.expectedError, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			if tt.expectRemoved {
+				assert.True(t, resp.State.Raw.IsNull())
+			} else if !tt.expectedError {
+				var result ConjurGrantResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, tt.expectAdmin, result.AdminOption.ValueBool())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGrantResource_Delete(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "!revoke") && contains(buf.String(), "member: !group admins")
+	})).Return(&conjurapi.PolicyResponse{}, nil)
+
+	r := &ConjurGrantResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGrantTestSchema()},
+	}
+	resp := &resource.DeleteResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGrantTestSchema()},
+	}
+	req.State.Set(ctx, testGrantModel(true))
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	mockV2.AssertExpectations(t)
+}
+
+func TestGrantResource_ImportState(t *testing.T) {
+	r := &ConjurGrantResource{}
+
+	ctx := context.Background()
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(getGrantTestSchema().Type().TerraformType(ctx), nil), Schema: getGrantTestSchema()},
+	}
+
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "layer/data/apps/app-layer:group/data/admins"}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+
+	var result ConjurGrantResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &result)...)
+	require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	assert.Equal(t, "app-layer", result.Role.Name.ValueString())
+	assert.Equal(t, "layer", result.Role.Kind.ValueString())
+	assert.Equal(t, "data/apps", result.Role.Branch.ValueString())
+	assert.Equal(t, "admins", result.Member.Name.ValueString())
+	assert.Equal(t, "group", result.Member.Kind.ValueString())
+	assert.Equal(t, "data", result.Member.Branch.ValueString())
+}
+
+func getGrantTestSchema() schema.Schema {
+	r := &ConjurGrantResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/cyberark/conjur-api-go/conjurapi"
 	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
 	"github.com/cyberark/terraform-provider-conjur/internal/policy"
 	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
@@ -22,6 +23,7 @@ var (
 	_ resource.Resource                   = &ConjurGroupResource{}
 	_ resource.ResourceWithConfigure      = &ConjurGroupResource{}
 	_ resource.ResourceWithValidateConfig = &ConjurGroupResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurGroupResource{}
 )
 
 func NewConjurGroupResource() resource.Resource {
@@ -124,6 +126,35 @@ func (r *ConjurGroupResource) Configure(ctx context.Context, req resource.Config
 	r.client = client
 }
 
+func (r *ConjurGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurGroupResourceModel
+	var groupPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		groupPolicy, err = r.generateGroupDeletionPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		groupPolicy, err = r.generateGroupPolicy(&data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate group policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, groupPolicy, data.Branch.ValueString(), &resp.Diagnostics)
+}
+
 func (r *ConjurGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = &conjurGroupMembersResource{}
+	_ resource.ResourceWithConfigure      = &conjurGroupMembersResource{}
+	_ resource.ResourceWithImportState    = &conjurGroupMembersResource{}
+	_ resource.ResourceWithValidateConfig = &conjurGroupMembersResource{}
+)
+
+// groupMemberKinds are the member kinds supported by the group membership API
+var groupMemberKinds = []string{"user", "host", "group"}
+
+type conjurGroupMembersResource struct {
+	client api.ClientV2
+}
+
+type groupMembersResourceModel struct {
+	GroupID types.String `tfsdk:"group_id"`
+	Members types.Set    `tfsdk:"members"`
+}
+
+type groupMemberModel struct {
+	Kind types.String `tfsdk:"kind"`
+	ID   types.String `tfsdk:"id"`
+}
+
+var groupMemberObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"kind": types.StringType,
+		"id":   types.StringType,
+	},
+}
+
+func NewConjurGroupMembersResource() resource.Resource {
+	return &conjurGroupMembersResource{}
+}
+
+func (r *conjurGroupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (r *conjurGroupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager group members resource. This resource manages the complete member list of a group: " +
+			"members added outside of Terraform are shown as drift and removed on the next apply. Do not combine it with `conjur_membership` for the same group.",
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Secrets Manager group role ID, e.g. 'data/test/test-users'",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The complete set of members of the group",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Kind of the member: 'user', 'host', or 'group'",
+						},
+						"id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Member role ID, e.g. 'data/test/bob'",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *conjurGroupMembersResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data groupMembersResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.GroupID, &resp.Diagnostics, "group_id")
+
+	if data.Members.IsNull() || data.Members.IsUnknown() {
+		return
+	}
+	var members []groupMemberModel
+	resp.Diagnostics.Append(data.Members.ElementsAs(ctx, &members, false)...)
+	for _, member := range members {
+		ValidateContainedIn(member.Kind, &resp.Diagnostics, "member kind", groupMemberKinds, false)
+		ValidateNonEmpty(member.ID, &resp.Diagnostics, "member id")
+	}
+}
+
+func (r *conjurGroupMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *conjurGroupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data groupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group may already have members, which are reconciled like any other drift
+	current, err := r.readGroupMembers(data.GroupID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read group members", err.Error())
+		return
+	}
+
+	desired := groupMembers(ctx, data.Members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data.GroupID.ValueString(), current, desired); err != nil {
+		resp.Diagnostics.AddError("Failed to update group members", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Created group members resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *conjurGroupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data groupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.GroupID.ValueString()
+	members, err := r.readGroupMembers(groupID)
+	if isNotFoundErr(err) {
+		resp.Diagnostics.AddWarning("Group Not Found", fmt.Sprintf("The group %q was not found in Conjur and will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the group exists and can be managed by the provider identity.", groupID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read group members", err.Error())
+		return
+	}
+
+	memberModels := make([]groupMemberModel, 0, len(members))
+	for _, m := range members {
+		memberModels = append(memberModels, groupMemberModel{
+			Kind: types.StringValue(m.Kind),
+			ID:   types.StringValue(m.ID),
+		})
+	}
+	memberSet, diags := types.SetValueFrom(ctx, groupMemberObjectType, memberModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Members = memberSet
+
+	tflog.Trace(ctx, "Read group members resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *conjurGroupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state groupMembersResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// State holds the members last read from Conjur, so out-of-band members are removed here
+	current := groupMembers(ctx, state.Members, &resp.Diagnostics)
+	desired := groupMembers(ctx, data.Members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data.GroupID.ValueString(), current, desired); err != nil {
+		resp.Diagnostics.AddError("Failed to update group members", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "Updated group members resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *conjurGroupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data groupMembersResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current := groupMembers(ctx, data.Members, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data.GroupID.ValueString(), current, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove group members, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "Removed group members resource")
+}
+
+func (r *conjurGroupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	groupID := strings.Trim(req.ID, "/")
+	if groupID == "" {
+		resp.Diagnostics.AddError("Invalid import ID", "Expected format: group_id, e.g. data/test/test-users")
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+}
+
+// readGroupMembers returns the members of a group that can be managed through the group membership API.
+// The policy owning the group is listed as a member too, so members holding ownership are skipped.
+func (r *conjurGroupMembersResource) readGroupMembers(groupID string) ([]conjurapi.GroupMember, error) {
+	roleMembers, err := r.client.RoleMembers(fmt.Sprintf("group:%s", groupID))
+	if err != nil {
+		return nil, err
+	}
+
+	members := []conjurapi.GroupMember{}
+	for _, m := range roleMembers {
+		if ownership, _ := m["ownership"].(bool); ownership {
+			continue
+		}
+		member, _ := m["member"].(string)
+		kind, id, err := splitFullyQualifiedID(member)
+		if err != nil || !slices.Contains(groupMemberKinds, kind) {
+			continue
+		}
+		members = append(members, conjurapi.GroupMember{Kind: kind, ID: id})
+	}
+	return members, nil
+}
+
+// reconcile adds and removes group members so that only the desired members remain
+func (r *conjurGroupMembersResource) reconcile(ctx context.Context, groupID string, current, desired []conjurapi.GroupMember) error {
+	add, remove := groupMembersDiff(current, desired)
+
+	for _, member := range remove {
+		if _, err := r.client.RemoveGroupMember(groupID, member); err != nil && !isNotFoundErr(err) {
+			return fmt.Errorf("unable to remove %s %q from group %q: %w", member.Kind, member.ID, groupID, err)
+		}
+		tflog.Debug(ctx, "Removed group member", map[string]interface{}{"group": groupID, "kind": member.Kind, "id": member.ID})
+	}
+	for _, member := range add {
+		if _, err := r.client.AddGroupMember(groupID, member); err != nil {
+			return fmt.Errorf("unable to add %s %q to group %q: %w", member.Kind, member.ID, groupID, err)
+		}
+		tflog.Debug(ctx, "Added group member", map[string]interface{}{"group": groupID, "kind": member.Kind, "id": member.ID})
+	}
+	return nil
+}
+
+// groupMembers converts a set of member objects into group membership API members
+func groupMembers(ctx context.Context, memberSet types.Set, diags *diag.Diagnostics) []conjurapi.GroupMember {
+	if memberSet.IsNull() || memberSet.IsUnknown() {
+		return nil
+	}
+	var members []groupMemberModel
+	diags.Append(memberSet.ElementsAs(ctx, &members, false)...)
+
+	result := make([]conjurapi.GroupMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, conjurapi.GroupMember{
+			Kind: m.Kind.ValueString(),
+			ID:   m.ID.ValueString(),
+		})
+	}
+	return result
+}
+
+// groupMembersDiff returns the members to add and remove to get from the current to the desired members, sorted by kind and ID
+func groupMembersDiff(current, desired []conjurapi.GroupMember) (add, remove []conjurapi.GroupMember) {
+	for _, member := range desired {
+		if !slices.Contains(current, member) && !slices.Contains(add, member) {
+			add = append(add, member)
+		}
+	}
+	for _, member := range current {
+		if !slices.Contains(desired, member) && !slices.Contains(remove, member) {
+			remove = append(remove, member)
+		}
+	}
+
+	compare := func(a, b conjurapi.GroupMember) int {
+		if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+		return strings.Compare(a.ID, b.ID)
+	}
+	slices.SortFunc(add, compare)
+	slices.SortFunc(remove, compare)
+	return add, remove
+}
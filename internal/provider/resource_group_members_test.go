@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConjurGroupMembersResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewConjurGroupMembersResource().Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestGroupMembersDiff(t *testing.T) {
+	alice := conjurapi.GroupMember{Kind: "user", ID: "alice@data-test"}
+	bob := conjurapi.GroupMember{Kind: "user", ID: "bob@data-test"}
+	app := conjurapi.GroupMember{Kind: "host", ID: "data/test/app"}
+	admins := conjurapi.GroupMember{Kind: "group", ID: "data/test/admins"}
+
+	tests := []struct {
+		name           string
+		current        []conjurapi.GroupMember
+		desired        []conjurapi.GroupMember
+		expectedAdd    []conjurapi.GroupMember
+		expectedRemove []conjurapi.GroupMember
+	}{
+		{
+			name:        "add all members to an empty group",
+			desired:     []conjurapi.GroupMember{bob, app, alice},
+			expectedAdd: []conjurapi.GroupMember{app, alice, bob},
+		},
+		{
+			name:    "no changes",
+			current: []conjurapi.GroupMember{alice, app},
+			desired: []conjurapi.GroupMember{app, alice},
+		},
+		{
+			name:           "add and remove only the difference",
+			current:        []conjurapi.GroupMember{alice, app, admins},
+			desired:        []conjurapi.GroupMember{alice, bob},
+			expectedAdd:    []conjurapi.GroupMember{bob},
+			expectedRemove: []conjurapi.GroupMember{admins, app},
+		},
+		{
+			name:           "remove all members",
+			current:        []conjurapi.GroupMember{alice, alice},
+			expectedRemove: []conjurapi.GroupMember{alice},
+		},
+		{
+			name:           "same ID with a different kind",
+			current:        []conjurapi.GroupMember{{Kind: "host", ID: "data/test/admins"}},
+			desired:        []conjurapi.GroupMember{admins},
+			expectedAdd:    []conjurapi.GroupMember{admins},
+			expectedRemove: []conjurapi.GroupMember{{Kind: "host", ID: "data/test/admins"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			add, remove := groupMembersDiff(tt.current, tt.desired)
+			assert.Equal(t, tt.expectedAdd, add)
+			assert.Equal(t, tt.expectedRemove, remove)
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testGroupMembersModel(t *testing.T, members ...groupMemberModel) groupMembersResourceModel {
+	memberSet, diags := types.SetValueFrom(context.Background(), groupMemberObjectType, members)
+	require.False(t, diags.HasError(), "%+v", diags)
+	return groupMembersResourceModel{
+		GroupID: types.StringValue("data/test/test-users"),
+		Members: memberSet,
+	}
+}
+
+func testGroupMember(kind, id string) groupMemberModel {
+	return groupMemberModel{Kind: types.StringValue(kind), ID: types.StringValue(id)}
+}
+
+func TestGroupMembersResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "reconciles existing members",
+			setupMock: func(mockClientV2 *mocks.MockClientV2) {
+				mockClientV2.On("RoleMembers", "group:data/test/test-users").Return([]map[string]interface{}{
+					{"member": "myaccount:policy:data/test", "admin_option": true, "ownership": true},
+					{"member": "myaccount:user:alice@data-test", "admin_option": false},
+					{"member": "myaccount:host:data/test/old-app", "admin_option": false},
+				}, nil)
+				mockClientV2.On("RemoveGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "host", ID: "data/test/old-app"}).
+					Return([]byte{}, nil)
+				mockClientV2.On("AddGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "host", ID: "data/test/app"}).
+					Return(&conjurapi.GroupMember{Kind: "host", ID: "data/test/app"}, nil)
+			},
+		},
+		{
+			name: "api error on add",
+			setupMock: func(mockClientV2 *mocks.MockClientV2) {
+				mockClientV2.On("RoleMembers", "group:data/test/test-users").Return([]map[string]interface{}{}, nil)
+				mockClientV2.On("AddGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "host", ID: "data/test/app"}).
+					Return(nil, fmt.Errorf("API error"))
+			},
+			expectedError: true,
+			errorContains: "unable to add host",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClientV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockClientV2)
+
+			r := &conjurGroupMembersResource{
+				client: mockClientV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGroupMembersTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGroupMembersTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			data := testGroupMembersModel(t, testGroupMember("user", "alice@data-test"), testGroupMember("host", "data/test/app"))
+			req.Plan.Set(ctx, &data)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			}
+			mockClientV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupMembersResource_Read(t *testing.T) {
+	tests := []struct {
+		name            string
+		roleMembers     []map[string]interface{}
+		err             error
+		expectedError   bool
+		expectRemoved   bool
+		expectedMembers []groupMemberModel
+	}{
+		{
+			name: "reports out-of-band members",
+			roleMembers: []map[string]interface{}{
+				{"member": "myaccount:policy:data/test", "admin_option": true, "ownership": true},
+				{"member": "myaccount:user:alice@data-test", "admin_option": false},
+				{"member": "myaccount:host:data/test/intruder", "admin_option": false},
+				{"member": "myaccount:layer:data/test/app-layer", "admin_option": false},
+			},
+			expectedMembers: []groupMemberModel{
+				testGroupMember("user", "alice@data-test"),
+				testGroupMember("host", "data/test/intruder"),
+			},
+		},
+		{
+			name:            "group has no members",
+			roleMembers:     []map[string]interface{}{},
+			expectedMembers: []groupMemberModel{},
+		},
+		{
+			name:          "group not found",
+			err:           fmt.Errorf("404 Not Found"),
+			expectRemoved: true,
+		},
+		{
+			name:          "api error",
+			err:           fmt.Errorf("connection error"),
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClientV2 := mocks.NewMockClientV2(t)
+			mockClientV2.On("RoleMembers", "group:data/test/test-users").Return(tt.roleMembers, tt.err)
+
+			r := &conjurGroupMembersResource{
+				client: mockClientV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGroupMembersTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getGroupMembersTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			data := testGroupMembersModel(t, testGroupMember("user", "alice@data-test"))
+			req.State.Set(ctx, &data)
+
+			r.Read(ctx, req, resp)
+
+			assert.Equal(t, tt.expectedError, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			if tt.expectRemoved {
+				assert.True(t, resp.State.Raw.IsNull())
+			} else if !tt.expectedError {
+				var result groupMembersResourceModel
+				resp.State.Get(ctx, &result)
+				var members []groupMemberModel
+				result.Members.ElementsAs(ctx, &members, false)
+				assert.ElementsMatch(t, tt.expectedMembers, members)
+			}
+			mockClientV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGroupMembersResource_Update(t *testing.T) {
+	mockClientV2 := mocks.NewMockClientV2(t)
+	mockClientV2.On("RemoveGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "host", ID: "data/test/intruder"}).
+		Return([]byte{}, nil)
+	mockClientV2.On("AddGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "group", ID: "data/test/admins"}).
+		Return(&conjurapi.GroupMember{Kind: "group", ID: "data/test/admins"}, nil)
+
+	r := &conjurGroupMembersResource{
+		client: mockClientV2,
+	}
+
+	ctx := context.Background()
+	state := testGroupMembersModel(t, testGroupMember("user", "alice@data-test"), testGroupMember("host", "data/test/intruder"))
+	plan := testGroupMembersModel(t, testGroupMember("user", "alice@data-test"), testGroupMember("group", "data/test/admins"))
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGroupMembersTestSchema()},
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGroupMembersTestSchema()},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGroupMembersTestSchema()},
+	}
+	req.Plan.Set(ctx, &plan)
+	req.State.Set(ctx, &state)
+
+	r.Update(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockClientV2.AssertExpectations(t)
+}
+
+func TestGroupMembersResource_Delete(t *testing.T) {
+	mockClientV2 := mocks.NewMockClientV2(t)
+	mockClientV2.On("RemoveGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "user", ID: "alice@data-test"}).
+		Return([]byte{}, nil)
+	mockClientV2.On("RemoveGroupMember", "data/test/test-users", conjurapi.GroupMember{Kind: "host", ID: "data/test/app"}).
+		Return(nil, fmt.Errorf("404 Not Found"))
+
+	r := &conjurGroupMembersResource{
+		client: mockClientV2,
+	}
+
+	ctx := context.Background()
+	data := testGroupMembersModel(t, testGroupMember("user", "alice@data-test"), testGroupMember("host", "data/test/app"))
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGroupMembersTestSchema()},
+	}
+	resp := &resource.DeleteResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getGroupMembersTestSchema()},
+	}
+	req.State.Set(ctx, &data)
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockClientV2.AssertExpectations(t)
+}
+
+func getGroupMembersTestSchema() schema.Schema {
+	r := &conjurGroupMembersResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
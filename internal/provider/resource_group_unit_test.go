@@ -421,6 +421,155 @@ func TestGroupResource_Delete(t *testing.T) {
 	}
 }
 
+func TestGroupResource_ModifyPlan(t *testing.T) {
+	group := ConjurGroupResourceModel{
+		Name:   types.StringValue("developers"),
+		Branch: types.StringValue("data/test"),
+	}
+
+	tests := []struct {
+		name            string
+		destroy         bool
+		setupMock       func(*mocks.MockClientV2)
+		expectedError   bool
+		expectedWarning bool
+		errorContains   string
+	}{
+		{
+			name: "created records are logged without a warning",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data/test", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return contains(buf.String(), "!group") && contains(buf.String(), "developers")
+				})).Return(&conjurapi.DryRunPolicyResponse{
+					Status:  "Valid YAML",
+					Created: conjurapi.DryRunPolicyResponseItems{Items: []conjurapi.Resource{{Identifier: "conjur:group:data/test/developers"}}},
+				}, nil)
+			},
+		},
+		{
+			name: "server validation errors fail the plan",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data/test", mock.Anything).Return(&conjurapi.DryRunPolicyResponse{
+					Status: "Invalid YAML",
+					Errors: []conjurapi.DryRunError{{Line: 1, Column: 3, Message: "Unrecognized data type '!gruop'"}},
+				}, nil)
+			},
+			expectedError: true,
+			errorContains: "Unrecognized data type",
+		},
+		{
+			name: "references to records created in the same apply are warnings",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data/test", mock.Anything).Return(&conjurapi.DryRunPolicyResponse{
+					Status: "Invalid YAML",
+					Errors: []conjurapi.DryRunError{{Line: 2, Column: 3, Message: "Group 'data/test/admins' not found in account 'conjur'"}},
+				}, nil)
+			},
+			expectedWarning: true,
+		},
+		{
+			name:    "destroy validates the deletion policy",
+			destroy: true,
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data/test", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return contains(buf.String(), "!delete")
+				})).Return(&conjurapi.DryRunPolicyResponse{
+					Status:  "Valid YAML",
+					Deleted: conjurapi.DryRunPolicyResponseItems{Items: []conjurapi.Resource{{Identifier: "conjur:group:data/test/developers"}}},
+				}, nil)
+			},
+			expectedWarning: true,
+		},
+		{
+			name: "dry run not supported by the server is skipped",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data/test", mock.Anything).Return(
+					nil, fmt.Errorf("Policy Dry Run is not supported in Secrets Manager SaaS"))
+			},
+		},
+		{
+			name: "other dry run errors are reported as warnings",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("DryRunPolicy", conjurapi.PolicyModePatch, "data/test", mock.Anything).Return(
+					nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurGroupResource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			groupSchema := getGroupTestSchema()
+			nullValue := tftypes.NewValue(groupSchema.Type().TerraformType(ctx), nil)
+
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: nullValue, Schema: groupSchema},
+				Plan:   tfsdk.Plan{Raw: nullValue, Schema: groupSchema},
+				State:  tfsdk.State{Raw: nullValue, Schema: groupSchema},
+			}
+			if tt.destroy {
+				req.State.Set(ctx, &group)
+			} else {
+				req.Plan.Set(ctx, &group)
+				req.Config.Raw = req.Plan.Raw
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				found := false
+				for _, diag := range resp.Diagnostics.Errors() {
+					if contains(diag.Detail(), tt.errorContains) {
+						found = true
+						break
+					}
+				}
+				assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectedWarning, resp.Diagnostics.WarningsCount() > 0)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+
+	t.Run("unchanged resource is not validated", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		r := &ConjurGroupResource{client: mockV2}
+
+		ctx := context.Background()
+		groupSchema := getGroupTestSchema()
+		req := resource.ModifyPlanRequest{
+			Config: tfsdk.Config{Schema: groupSchema},
+			Plan:   tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: groupSchema},
+			State:  tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: groupSchema},
+		}
+		req.Plan.Set(ctx, &group)
+		req.State.Set(ctx, &group)
+		req.Config.Raw = req.Plan.Raw
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+		r.ModifyPlan(ctx, req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		mockV2.AssertNotCalled(t, "DryRunPolicy", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
 func getGroupTestSchema() schema.Schema {
 	r := &ConjurGroupResource{}
 	var schemaResp resource.SchemaResponse
@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurHostFactoryResource{}
+	_ resource.ResourceWithConfigure      = &ConjurHostFactoryResource{}
+	_ resource.ResourceWithImportState    = &ConjurHostFactoryResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurHostFactoryResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurHostFactoryResource{}
+)
+
+func NewConjurHostFactoryResource() resource.Resource {
+	return &ConjurHostFactoryResource{}
+}
+
+// ConjurHostFactoryResource defines the resource implementation.
+type ConjurHostFactoryResource struct {
+	client api.ClientV2
+}
+
+// ConjurHostFactoryResourceModel describes the resource data model.
+type ConjurHostFactoryResourceModel struct {
+	Name        types.String      `tfsdk:"name"`
+	Branch      types.String      `tfsdk:"branch"`
+	Owner       *ConjurOwnerModel `tfsdk:"owner"`
+	Annotations map[string]string `tfsdk:"annotations"`
+	Layers      types.Set         `tfsdk:"layers"`
+	FullID      types.String      `tfsdk:"full_id"`
+}
+
+func (r *ConjurHostFactoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host_factory"
+}
+
+func (r *ConjurHostFactoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager Host Factory resource. This resource creates a host factory in Conjur using policy. " +
+			"Hosts created with the host factory's tokens are added to its layers. Use the `conjur_host_factory_token` and `conjur_host_factory_host` ephemeral resources to create tokens and hosts.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the host factory",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch of the host factory",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Owner of the host factory",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "Owner kind (user, group, etc.)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Owner identifier",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Key-value annotations for the host factory",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"layers": schema.SetAttribute{
+				MarkdownDescription: "Full IDs of the layers hosts created by the host factory are added to (e.g. `data/apps/my-layer`)",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"full_id": schema.StringAttribute{
+				MarkdownDescription: "Computed identifier: `<branch>/<name>`, used to create host factory tokens",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConjurHostFactoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurHostFactoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.Name, &resp.Diagnostics, "Host factory name")
+	ValidateBranch(data.Branch, &resp.Diagnostics, "branch")
+}
+
+func (r *ConjurHostFactoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurHostFactoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurHostFactoryResourceModel
+	var hostFactoryPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		hostFactoryPolicy, err = r.generateHostFactoryDeletionPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		hostFactoryPolicy, err = r.generateHostFactoryPolicy(ctx, &data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate host factory policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, hostFactoryPolicy, data.Branch.ValueString(), &resp.Diagnostics)
+}
+
+func (r *ConjurHostFactoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurHostFactoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostFactoryPolicy, err := r.generateHostFactoryPolicy(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate host factory policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, hostFactoryPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply host factory policy: %s", err))
+		return
+	}
+
+	data.FullID = types.StringValue(joinConjurID(data.Branch.ValueString(), data.Name.ValueString()))
+
+	tflog.Trace(ctx, "created host factory resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurHostFactoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurHostFactoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullID := joinConjurID(data.Branch.ValueString(), data.Name.ValueString())
+	hostFactoryID := fmt.Sprintf("host_factory:%s", fullID)
+	hostFactory, err := r.client.Resource(hostFactoryID)
+	if err != nil {
+		// Remove the host factory if it has been removed from Conjur (or is inaccessible to the provider)
+		if isNotFoundErr(err) {
+			resp.Diagnostics.AddWarning("Host Factory Not Found", fmt.Sprintf("The host factory %q was not found in Conjur and will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the host factory exists and can be managed by the provider identity.", hostFactoryID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Conjur host factory",
+			fmt.Sprintf("Unable to read host factory %q: %s", hostFactoryID, err),
+		)
+		return
+	}
+
+	// Layers are read back so imported host factories match their configuration instead of being replaced
+	if layers, ok := hostFactoryLayers(hostFactory); ok {
+		layerSet, diags := types.SetValueFrom(ctx, types.StringType, layers)
+		resp.Diagnostics.Append(diags...)
+		data.Layers = layerSet
+	}
+	if annotations := recordAnnotations(hostFactory); len(annotations) > 0 || data.Annotations != nil {
+		data.Annotations = annotations
+	}
+	refreshRecordOwner(hostFactory, data.Branch.ValueString(), data.Owner)
+	data.FullID = types.StringValue(fullID)
+
+	tflog.Trace(ctx, "read host factory resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurHostFactoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurHostFactoryResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes are marked RequiresReplace, so this should never be called
+	resp.Diagnostics.AddError("Update Not Supported", "This resource does not support in-place updates. Please recreate the resource to apply changes.")
+}
+
+func (r *ConjurHostFactoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurHostFactoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostFactoryPolicy, err := r.generateHostFactoryDeletionPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Deletion Policy", fmt.Sprintf("Could not generate host factory deletion policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, hostFactoryPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Deletion Policy", fmt.Sprintf("Could not apply host factory deletion policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted host factory resource")
+}
+
+func (r *ConjurHostFactoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := strings.Trim(req.ID, "/")
+	if id == "" || !strings.Contains(id, "/") {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected format: <branch>/<name>, e.g. data/apps/my-factory")
+		return
+	}
+
+	branch, name := splitParentAndName(id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("full_id"), id)...)
+}
+
+// generateHostFactoryPolicy creates a Conjur policy for creating a host factory
+func (r *ConjurHostFactoryResource) generateHostFactoryPolicy(ctx context.Context, data *ConjurHostFactoryResourceModel) (string, error) {
+	owner, err := policyOwnerRef(data.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	hostFactory := policyHostFactory{
+		Id:          data.Name.ValueString(),
+		Owner:       owner,
+		Annotations: policyAnnotations(data.Annotations),
+	}
+
+	// Layers are referenced by absolute ID so they can live outside the host factory's branch
+	for _, layer := range normalizedIDs(ctx, data.Layers) {
+		hostFactory.Layers = append(hostFactory.Layers, conjurpolicy.LayerRef("/"+layer))
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{hostFactory}
+
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// generateHostFactoryDeletionPolicy creates a policy to delete a host factory
+func (r *ConjurHostFactoryResource) generateHostFactoryDeletionPolicy(data *ConjurHostFactoryResourceModel) (string, error) {
+	delete := policyDelete{
+		Record: policyRef{Kind: "host-factory", Id: data.Name.ValueString()},
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{delete}
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal deletion policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// hostFactoryLayers returns the sorted IDs of the layers of a host factory from a Conjur resource response.
+// ok is false when the response doesn't list the layers.
+func hostFactoryLayers(hostFactory map[string]interface{}) (layers []string, ok bool) {
+	rawLayers, ok := hostFactory["layers"].([]interface{})
+	if !ok {
+		return nil, false
+	}
+	layers = []string{}
+	for _, l := range rawLayers {
+		layerID, _ := l.(string)
+		kind, id, err := splitFullyQualifiedID(layerID)
+		if err != nil || kind != "layer" {
+			continue
+		}
+		layers = append(layers, id)
+	}
+	sort.Strings(layers)
+	return layers, true
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConjurHostFactoryResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := NewConjurHostFactoryResource()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	ds.Schema(ctx, schemaRequest, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestConjurHostFactoryResource_generateHostFactoryPolicy(t *testing.T) {
+	r := &ConjurHostFactoryResource{}
+	ctx := context.Background()
+
+	t.Run("Minimum host factory fields provided", func(t *testing.T) {
+		data := &ConjurHostFactoryResourceModel{
+			Name:   types.StringValue("app-factory"),
+			Branch: types.StringValue("data/apps"),
+			Layers: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("data/apps/app-layer")}),
+		}
+
+		hostFactoryPolicy, err := r.generateHostFactoryPolicy(ctx, data)
+
+		require.NoError(t, err)
+		assert.Contains(t, hostFactoryPolicy, "!host-factory")
+		assert.Contains(t, hostFactoryPolicy, "id: app-factory")
+		assert.Contains(t, hostFactoryPolicy, "layers: [!layer /data/apps/app-layer]")
+		assert.NotContains(t, hostFactoryPolicy, "owner")
+	})
+
+	t.Run("Owner and annotations", func(t *testing.T) {
+		data := &ConjurHostFactoryResourceModel{
+			Name:   types.StringValue("app-factory"),
+			Branch: types.StringValue("data/apps"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("group"),
+				ID:   types.StringValue("admins"),
+			},
+			Annotations: map[string]string{"team": "payments"},
+			Layers:      types.SetValueMust(types.StringType, []attr.Value{types.StringValue("/data/apps/app-layer")}),
+		}
+
+		hostFactoryPolicy, err := r.generateHostFactoryPolicy(ctx, data)
+
+		require.NoError(t, err)
+		assert.Contains(t, hostFactoryPolicy, "owner: !group admins")
+		assert.Contains(t, hostFactoryPolicy, "team: payments")
+		assert.Contains(t, hostFactoryPolicy, "!layer /data/apps/app-layer")
+	})
+}
+
+func TestConjurHostFactoryResource_generateHostFactoryDeletionPolicy(t *testing.T) {
+	r := &ConjurHostFactoryResource{}
+
+	deletionPolicy, err := r.generateHostFactoryDeletionPolicy(&ConjurHostFactoryResourceModel{
+		Name:   types.StringValue("app-factory"),
+		Branch: types.StringValue("data/apps"),
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, deletionPolicy, "!delete")
+	assert.Contains(t, deletionPolicy, "record: !host-factory app-factory")
+}
+
+// TestGenerateHostFactoryPolicy_YAMLInjection tests that user input cannot inject additional YAML statements
+func TestGenerateHostFactoryPolicy_YAMLInjection(t *testing.T) {
+	r := &ConjurHostFactoryResource{}
+	ctx := context.Background()
+
+	testCases := []struct {
+		name        string
+		factoryName string
+		layer       string
+	}{
+		{
+			name:        "newline injection in name",
+			factoryName: "factory\n- !delete\n  record: !variable injected",
+			layer:       "data/app-layer",
+		},
+		{
+			name:        "newline injection in layer",
+			factoryName: "app-factory",
+			layer:       "data/layer\n- !delete\n  record: !variable injected",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := &ConjurHostFactoryResourceModel{
+				Name:   types.StringValue(tc.factoryName),
+				Branch: types.StringValue("data"),
+				Layers: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(tc.layer)}),
+			}
+
+			policy, err := r.generateHostFactoryPolicy(ctx, data)
+			require.NoError(t, err)
+
+			var policyStatements []yaml.Node
+			err = yaml.Unmarshal([]byte(policy), &policyStatements)
+			require.NoError(t, err, "Policy should be valid YAML. Policy: %s", policy)
+			require.Len(t, policyStatements, 1, "Policy: %s", policy)
+			assert.Equal(t, "!host-factory", policyStatements[0].Tag)
+
+			var hostFactory struct {
+				Id     string                     `yaml:"id"`
+				Layers []conjurpolicy.ResourceRef `yaml:"layers"`
+			}
+			require.NoError(t, policyStatements[0].Decode(&hostFactory))
+			assert.Equal(t, tc.factoryName, hostFactory.Id)
+			require.Len(t, hostFactory.Layers, 1)
+			assert.Equal(t, "/"+tc.layer, hostFactory.Layers[0].Id)
+		})
+	}
+}
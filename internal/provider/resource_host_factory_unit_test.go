@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHostFactoryResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          ConjurHostFactoryResourceModel
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful host factory creation",
+			data: ConjurHostFactoryResourceModel{
+				Name:   types.StringValue("app-factory"),
+				Branch: types.StringValue("data/apps"),
+				Layers: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("data/apps/app-layer"),
+				}),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					content := buf.String()
+					return contains(content, "!host-factory") && contains(content, "!layer /data/apps/app-layer")
+				})).Return(&conjurapi.PolicyResponse{}, nil)
+			},
+		},
+		{
+			name: "API error during creation",
+			data: ConjurHostFactoryResourceModel{
+				Name:   types.StringValue("app-factory"),
+				Branch: types.StringValue("data"),
+				Layers: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("data/app-layer"),
+				}),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(
+					nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Could not apply host factory policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurHostFactoryResource{
+				client: mockV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getHostFactoryTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getHostFactoryTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.Plan.Set(ctx, &tt.data)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurHostFactoryResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, "data/apps/app-factory", result.FullID.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHostFactoryResource_Read(t *testing.T) {
+	tests := []struct {
+		name           string
+		setupMock      func(*mocks.MockClientV2)
+		expectedError  bool
+		shouldRemove   bool
+		expectedLayers []string
+	}{
+		{
+			name: "host factory exists",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "host_factory:data/apps/app-factory").Return(map[string]interface{}{
+					"id":     "conjur:host_factory:data/apps/app-factory",
+					"layers": []interface{}{"conjur:layer:data/apps/app-layer", "conjur:layer:data/shared/base-layer"},
+				}, nil)
+			},
+			expectedLayers: []string{"data/apps/app-layer", "data/shared/base-layer"},
+		},
+		{
+			name: "layers kept when not returned",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "host_factory:data/apps/app-factory").Return(map[string]interface{}{
+					"id": "conjur:host_factory:data/apps/app-factory",
+				}, nil)
+			},
+			expectedLayers: []string{"data/apps/app-layer"},
+		},
+		{
+			name: "host factory removed - removes from state",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "host_factory:data/apps/app-factory").Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			shouldRemove: true,
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "host_factory:data/apps/app-factory").Return(nil, fmt.Errorf("connection error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurHostFactoryResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getHostFactoryTestSchema()},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getHostFactoryTestSchema()},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &ConjurHostFactoryResourceModel{
+				Name:   types.StringValue("app-factory"),
+				Branch: types.StringValue("data/apps"),
+				Layers: types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("data/apps/app-layer"),
+				}),
+			})
+
+			r.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurHostFactoryResourceModel
+				diag := resp.State.Get(ctx, &result)
+				if tt.shouldRemove {
+					assert.True(t, diag.HasError() || result.Name.IsNull())
+				} else {
+					assert.Equal(t, "data/apps/app-factory", result.FullID.ValueString())
+					assert.Equal(t, tt.expectedLayers, normalizedIDs(ctx, result.Layers))
+				}
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHostFactoryResource_ImportState(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("Resource", "host_factory:data/apps/app-factory").Return(map[string]interface{}{
+		"id":     "conjur:host_factory:data/apps/app-factory",
+		"owner":  "conjur:policy:data/apps",
+		"layers": []interface{}{"conjur:layer:data/shared/base-layer", "conjur:layer:data/apps/app-layer"},
+	}, nil)
+
+	r := &ConjurHostFactoryResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	testSchema := getHostFactoryTestSchema()
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil), Schema: testSchema},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "data/apps/app-factory"}, importResp)
+	require.False(t, importResp.Diagnostics.HasError(), "%+v", importResp.Diagnostics)
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	require.False(t, readResp.Diagnostics.HasError(), "%+v", readResp.Diagnostics)
+
+	// The plan for the configuration the host factory was created with must match the imported state,
+	// otherwise the replacement of the layers would destroy the host factory and its tokens
+	plan := tfsdk.Plan{Raw: tftypes.NewValue(testSchema.Type().TerraformType(ctx), nil), Schema: testSchema}
+	plan.Set(ctx, &ConjurHostFactoryResourceModel{
+		Name:   types.StringValue("app-factory"),
+		Branch: types.StringValue("data/apps"),
+		Layers: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("data/apps/app-layer"),
+			types.StringValue("data/shared/base-layer"),
+		}),
+		FullID: types.StringValue("data/apps/app-factory"),
+	})
+	assert.True(t, plan.Raw.Equal(readResp.State.Raw), "imported state differs from the configuration:\n%s\n%s", readResp.State.Raw, plan.Raw)
+	mockV2.AssertExpectations(t)
+}
+
+func TestHostFactoryResource_Delete(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "record: !host-factory app-factory")
+	})).Return(&conjurapi.PolicyResponse{}, nil)
+
+	r := &ConjurHostFactoryResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	data := ConjurHostFactoryResourceModel{
+		Name:   types.StringValue("app-factory"),
+		Branch: types.StringValue("data"),
+		Layers: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("data/app-layer"),
+		}),
+	}
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getHostFactoryTestSchema()},
+	}
+	resp := &resource.DeleteResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getHostFactoryTestSchema()},
+	}
+	req.State.Set(ctx, &data)
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	mockV2.AssertExpectations(t)
+}
+
+func getHostFactoryTestSchema() schema.Schema {
+	r := &ConjurHostFactoryResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,422 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurLayerResource{}
+	_ resource.ResourceWithConfigure      = &ConjurLayerResource{}
+	_ resource.ResourceWithImportState    = &ConjurLayerResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurLayerResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurLayerResource{}
+)
+
+func NewConjurLayerResource() resource.Resource {
+	return &ConjurLayerResource{}
+}
+
+// ConjurLayerResource defines the resource implementation.
+type ConjurLayerResource struct {
+	client api.ClientV2
+}
+
+// ConjurLayerResourceModel describes the resource data model.
+type ConjurLayerResourceModel struct {
+	Name        types.String      `tfsdk:"name"`
+	Branch      types.String      `tfsdk:"branch"`
+	Owner       *ConjurOwnerModel `tfsdk:"owner"`
+	Annotations map[string]string `tfsdk:"annotations"`
+	Hosts       types.Set         `tfsdk:"hosts"`
+}
+
+func (r *ConjurLayerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_layer"
+}
+
+func (r *ConjurLayerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager Layer resource. This resource creates a layer in Conjur using policy and manages the hosts that belong to it. " +
+			"When `hosts` is set, the layer's host membership is managed authoritatively.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the layer",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch of the layer",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Owner of the layer",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "Owner kind (user, group, etc.)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Owner identifier",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Key-value annotations for the layer",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.SetAttribute{
+				MarkdownDescription: "Full IDs of the hosts that belong to the layer (e.g. `data/apps/my-host`)",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ConjurLayerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurLayerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.Name, &resp.Diagnostics, "Layer name")
+	ValidateBranch(data.Branch, &resp.Diagnostics, "branch")
+
+	// Hosts are read back without slashes, so the same form is required in config to avoid perpetual drift
+	if !data.Hosts.IsNull() && !data.Hosts.IsUnknown() {
+		var hosts []types.String
+		resp.Diagnostics.Append(data.Hosts.ElementsAs(ctx, &hosts, false)...)
+		for _, host := range hosts {
+			if host.IsUnknown() {
+				continue
+			}
+			if strings.TrimSpace(host.ValueString()) == "" || strings.HasPrefix(host.ValueString(), "/") || strings.HasSuffix(host.ValueString(), "/") {
+				resp.Diagnostics.AddError(
+					"Invalid host",
+					fmt.Sprintf("Host ID %q must be a non-empty full ID without leading or trailing slashes (e.g. data/apps/my-host)", host.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+func (r *ConjurLayerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurLayerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurLayerResourceModel
+	var layerPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		layerPolicy, err = r.generateLayerDeletionPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var previousHosts []string
+		if !req.State.Raw.IsNull() {
+			var state ConjurLayerResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			previousHosts = normalizedIDs(ctx, state.Hosts)
+		}
+		layerPolicy, err = r.generateLayerPolicy(ctx, &data, previousHosts)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate layer policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, layerPolicy, data.Branch.ValueString(), &resp.Diagnostics)
+}
+
+func (r *ConjurLayerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurLayerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layerPolicy, err := r.generateLayerPolicy(ctx, &data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate layer policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, layerPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply layer policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created layer resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurLayerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurLayerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layerID := fmt.Sprintf("layer:%s", joinConjurID(data.Branch.ValueString(), data.Name.ValueString()))
+	exists, err := r.client.RoleExists(layerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Conjur layer",
+			fmt.Sprintf("Unable to check if layer %q exists: %s", layerID, err),
+		)
+		return
+	}
+
+	// Remove the layer if it has been removed from Conjur (or is inaccessible to the provider)
+	if !exists {
+		resp.Diagnostics.AddWarning("Layer Not Found", fmt.Sprintf("The layer %q was not found in Conjur and will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the layer exists and can be managed by the provider identity.", layerID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	members, err := r.client.RoleMembers(layerID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Conjur layer",
+			fmt.Sprintf("Unable to read members of layer %q: %s", layerID, err),
+		)
+		return
+	}
+
+	// Membership is only managed when hosts are configured, so hosts granted outside of Terraform are left alone otherwise
+	if !data.Hosts.IsNull() {
+		hosts := []string{}
+		for _, m := range members {
+			// A host owning the layer is listed as a member too, but isn't granted the layer by this resource
+			if ownership, _ := m["ownership"].(bool); ownership {
+				continue
+			}
+			member, _ := m["member"].(string)
+			kind, id, err := splitFullyQualifiedID(member)
+			if err != nil || kind != "host" {
+				continue
+			}
+			hosts = append(hosts, id)
+		}
+
+		hostSet, diags := types.SetValueFrom(ctx, types.StringType, hosts)
+		resp.Diagnostics.Append(diags...)
+		data.Hosts = hostSet
+	}
+
+	// Owner and annotations can't be read back through the role APIs, so assume they are unchanged
+	tflog.Trace(ctx, "read layer resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurLayerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state ConjurLayerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only hosts can change in place, so grant the added hosts and revoke the removed ones
+	layerPolicy, err := r.generateLayerPolicy(ctx, &data, normalizedIDs(ctx, state.Hosts))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate layer policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, layerPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply layer policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated layer resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurLayerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurLayerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	layerPolicy, err := r.generateLayerDeletionPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Deletion Policy", fmt.Sprintf("Could not generate layer deletion policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, layerPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Deletion Policy", fmt.Sprintf("Could not apply layer deletion policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted layer resource")
+}
+
+func (r *ConjurLayerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := strings.Trim(req.ID, "/")
+	if id == "" || !strings.Contains(id, "/") {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected format: <branch>/<name>, e.g. data/apps/my-layer")
+		return
+	}
+
+	branch, name := splitParentAndName(id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// generateLayerPolicy creates a Conjur policy declaring the layer and granting it to its hosts.
+// Hosts in previousHosts that are no longer configured are revoked from the layer.
+func (r *ConjurLayerResource) generateLayerPolicy(ctx context.Context, data *ConjurLayerResourceModel, previousHosts []string) (string, error) {
+	owner, err := policyOwnerRef(data.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	layer := policyLayer{
+		Id:          data.Name.ValueString(),
+		Owner:       owner,
+		Annotations: policyAnnotations(data.Annotations),
+	}
+	policyStatements := conjurpolicy.PolicyStatements{layer}
+
+	layerRef := conjurpolicy.LayerRef(data.Name.ValueString())
+	hosts := normalizedIDs(ctx, data.Hosts)
+	for _, host := range hosts {
+		if slices.Contains(previousHosts, host) {
+			continue
+		}
+		policyStatements = append(policyStatements, conjurpolicy.Grant{
+			Role:   layerRef,
+			Member: conjurpolicy.HostRef("/" + host),
+		})
+	}
+	for _, host := range previousHosts {
+		if slices.Contains(hosts, host) {
+			continue
+		}
+		policyStatements = append(policyStatements, policyRevoke{
+			Role:   layerRef,
+			Member: conjurpolicy.HostRef("/" + host),
+		})
+	}
+
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// generateLayerDeletionPolicy creates a policy to delete a layer
+func (r *ConjurLayerResource) generateLayerDeletionPolicy(data *ConjurLayerResourceModel) (string, error) {
+	delete := conjurpolicy.Delete{
+		Record: conjurpolicy.LayerRef(data.Name.ValueString()),
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{delete}
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal deletion policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// normalizedIDs returns the sorted IDs of a set of full record IDs. Records are referenced by absolute
+// ID in policy, so leading and trailing slashes are dropped here and added back when generating policy.
+func normalizedIDs(ctx context.Context, idSet types.Set) []string {
+	if idSet.IsNull() || idSet.IsUnknown() {
+		return nil
+	}
+	var ids []string
+	idSet.ElementsAs(ctx, &ids, false)
+	for i, id := range ids {
+		ids[i] = strings.Trim(id, "/")
+	}
+	slices.Sort(ids)
+	return ids
+}
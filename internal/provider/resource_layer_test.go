@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConjurLayerResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := NewConjurLayerResource()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	ds.Schema(ctx, schemaRequest, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestConjurLayerResource_generateLayerPolicy(t *testing.T) {
+	r := &ConjurLayerResource{}
+	ctx := context.Background()
+
+	hostSet := func(hosts ...string) types.Set {
+		values := make([]attr.Value, 0, len(hosts))
+		for _, h := range hosts {
+			values = append(values, types.StringValue(h))
+		}
+		return types.SetValueMust(types.StringType, values)
+	}
+
+	t.Run("Minimum layer fields provided", func(t *testing.T) {
+		data := &ConjurLayerResourceModel{
+			Name:   types.StringValue("app-layer"),
+			Branch: types.StringValue("data"),
+			Hosts:  types.SetNull(types.StringType),
+		}
+
+		layerPolicy, err := r.generateLayerPolicy(ctx, data, nil)
+
+		require.NoError(t, err)
+		assert.Contains(t, layerPolicy, "!layer")
+		assert.Contains(t, layerPolicy, "id: app-layer")
+		assert.NotContains(t, layerPolicy, "!grant")
+	})
+
+	t.Run("Owner, annotations and hosts", func(t *testing.T) {
+		data := &ConjurLayerResourceModel{
+			Name:   types.StringValue("app-layer"),
+			Branch: types.StringValue("data/apps"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("group"),
+				ID:   types.StringValue("admins"),
+			},
+			Annotations: map[string]string{"team": "payments"},
+			Hosts:       hostSet("data/apps/host-1", "data/apps/host-2"),
+		}
+
+		layerPolicy, err := r.generateLayerPolicy(ctx, data, nil)
+
+		require.NoError(t, err)
+		assert.Contains(t, layerPolicy, "owner: !group admins")
+		assert.Contains(t, layerPolicy, "team: payments")
+		assert.Contains(t, layerPolicy, "role: !layer app-layer")
+		assert.Contains(t, layerPolicy, "member: !host /data/apps/host-1")
+		assert.Contains(t, layerPolicy, "member: !host /data/apps/host-2")
+	})
+
+	t.Run("Only changed hosts are granted and revoked", func(t *testing.T) {
+		data := &ConjurLayerResourceModel{
+			Name:   types.StringValue("app-layer"),
+			Branch: types.StringValue("data"),
+			Hosts:  hostSet("data/host-1", "data/host-3"),
+		}
+
+		layerPolicy, err := r.generateLayerPolicy(ctx, data, []string{"data/host-1", "data/host-2"})
+		require.NoError(t, err)
+
+		var statements []yaml.Node
+		require.NoError(t, yaml.Unmarshal([]byte(layerPolicy), &statements))
+		require.Len(t, statements, 3, "Policy: %s", layerPolicy)
+		assert.Equal(t, "!layer", statements[0].Tag)
+		assert.Equal(t, "!grant", statements[1].Tag)
+		assert.Equal(t, "!revoke", statements[2].Tag)
+		assert.Contains(t, layerPolicy, "member: !host /data/host-3")
+		assert.Contains(t, layerPolicy, "member: !host /data/host-2")
+		assert.NotContains(t, layerPolicy, "/data/host-1")
+	})
+}
+
+func TestConjurLayerResource_generateLayerDeletionPolicy(t *testing.T) {
+	r := &ConjurLayerResource{}
+
+	deletionPolicy, err := r.generateLayerDeletionPolicy(&ConjurLayerResourceModel{
+		Name:   types.StringValue("app-layer"),
+		Branch: types.StringValue("data"),
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, deletionPolicy, "!delete")
+	assert.Contains(t, deletionPolicy, "record: !layer app-layer")
+}
+
+// TestGenerateLayerPolicy_YAMLInjection tests that user input cannot inject additional YAML statements
+func TestGenerateLayerPolicy_YAMLInjection(t *testing.T) {
+	r := &ConjurLayerResource{}
+	ctx := context.Background()
+
+	testCases := []struct {
+		name      string
+		layerName string
+		host      string
+	}{
+		{
+			name:      "newline injection in name",
+			layerName: "layer\n- !delete\n  record: !variable injected",
+		},
+		{
+			name:      "newline injection in host",
+			layerName: "app-layer",
+			host:      "data/host\n- !delete\n  record: !variable injected",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := &ConjurLayerResourceModel{
+				Name:   types.StringValue(tc.layerName),
+				Branch: types.StringValue("data"),
+				Hosts:  types.SetNull(types.StringType),
+			}
+			expectedStatements := 1
+			if tc.host != "" {
+				data.Hosts = types.SetValueMust(types.StringType, []attr.Value{types.StringValue(tc.host)})
+				expectedStatements = 2
+			}
+
+			policy, err := r.generateLayerPolicy(ctx, data, nil)
+			require.NoError(t, err)
+
+			var policyStatements conjurpolicy.PolicyStatements
+			err = yaml.Unmarshal([]byte(policy), &policyStatements)
+			require.NoError(t, err, "Policy should be valid YAML. Policy: %s", policy)
+			assert.Len(t, policyStatements, expectedStatements, "Policy: %s", policy)
+
+			if tc.host != "" {
+				grant, ok := policyStatements[1].(conjurpolicy.Grant)
+				require.True(t, ok, "Second statement should be a Grant statement. Policy: %s", policy)
+				assert.Equal(t, "/"+tc.host, grant.Member.Id)
+			}
+		})
+	}
+}
@@ -6,11 +6,13 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/cyberark/conjur-api-go/conjurapi"
 	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -27,6 +29,14 @@ var (
 	_ resource.ResourceWithConfigure      = &ConjurPermissionResource{}
 	_ resource.ResourceWithImportState    = &ConjurPermissionResource{}
 	_ resource.ResourceWithValidateConfig = &ConjurPermissionResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurPermissionResource{}
+)
+
+var (
+	// permissionRoleKinds are the record kinds that can be permitted privileges
+	permissionRoleKinds = []string{"user", "group", "host", "layer", "variable", "policy"}
+	// permissionResourceKinds are the record kinds privileges can be permitted on
+	permissionResourceKinds = []string{"user", "group", "host", "layer", "variable", "policy", "webservice"}
 )
 
 func NewConjurPermissionResource() resource.Resource {
@@ -40,9 +50,11 @@ type ConjurPermissionResource struct {
 
 // ConjurPermissionResourceModel describes the resource data model.
 type ConjurPermissionResourceModel struct {
-	Role       RoleModel     `tfsdk:"role"`
-	Resource   ResourceModel `tfsdk:"resource"`
-	Privileges types.List    `tfsdk:"privileges"`
+	Role             RoleModel     `tfsdk:"role"`
+	Resource         ResourceModel `tfsdk:"resource"`
+	Privileges       types.List    `tfsdk:"privileges"`
+	Authoritative    types.Bool    `tfsdk:"authoritative"`
+	StrictPrivileges types.Bool    `tfsdk:"strict_privileges"`
 }
 
 // RoleModel represents the nested "role" block
@@ -114,10 +126,21 @@ func (r *ConjurPermissionResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"privileges": schema.ListAttribute{
-				MarkdownDescription: "List of privileges to grant on the resource",
+				MarkdownDescription: "List of privileges to grant on the resource. The standard privileges are `read`, `update`, `execute` and `create`, or `read`, `authenticate` and `execute` for webservices, but any privilege name is accepted unless `strict_privileges` is set",
 				ElementType:         types.StringType,
 				Required:            true,
 			},
+			"authoritative": schema.BoolAttribute{
+				MarkdownDescription: "Whether the resource manages every privilege of the role on the resource. When `true` (the default) privileges that are not listed are denied. " +
+					"When `false` only the listed privileges are permitted and revoked, so several resources or policies can grant privileges on the same role and resource.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"strict_privileges": schema.BoolAttribute{
+				MarkdownDescription: "Only accept the standard privileges of the resource kind in `privileges`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -131,16 +154,22 @@ func (r *ConjurPermissionResource) ValidateConfig(ctx context.Context, req resou
 
 	// Validate role
 	ValidateNonEmpty(data.Role.Name, &resp.Diagnostics, "Role name")
-	ValidateContainedIn(data.Role.Kind, &resp.Diagnostics, "Role kind", []string{"user", "group", "host", "layer", "variable", "policy"}, false)
+	ValidateContainedIn(data.Role.Kind, &resp.Diagnostics, "Role kind", permissionRoleKinds, false)
 	ValidateBranch(data.Role.Branch, &resp.Diagnostics, "role branch")
 
 	// Validate resource
 	ValidateNonEmpty(data.Resource.Name, &resp.Diagnostics, "Resource name")
-	ValidateContainedIn(data.Resource.Kind, &resp.Diagnostics, "Resource kind", []string{"user", "group", "host", "layer", "variable", "policy"}, false)
+	ValidateContainedIn(data.Resource.Kind, &resp.Diagnostics, "Resource kind", permissionResourceKinds, false)
 	ValidateBranch(data.Resource.Branch, &resp.Diagnostics, "resource branch")
 
-	// Validate privileges
-	ValidatePrivileges(data.Privileges, &resp.Diagnostics, "privileges")
+	// Validate privileges against those supported by the resource kind in strict mode, otherwise any privilege name is accepted
+	if data.StrictPrivileges.ValueBool() {
+		if !data.Resource.Kind.IsUnknown() {
+			ValidatePrivileges(data.Privileges, &resp.Diagnostics, "privileges", resourcePrivileges(data.Resource.Kind.ValueString()))
+		}
+	} else {
+		ValidatePrivileges(data.Privileges, &resp.Diagnostics, "privileges", nil)
+	}
 }
 
 func (r *ConjurPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -155,6 +184,43 @@ func (r *ConjurPermissionResource) Configure(ctx context.Context, req resource.C
 	r.client = client
 }
 
+func (r *ConjurPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurPermissionResourceModel
+	var branch, permissionPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branch, permissionPolicy, err = r.generatePermissionDenyPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		var previous *ConjurPermissionResourceModel
+		if !req.State.Raw.IsNull() {
+			previous = &ConjurPermissionResourceModel{}
+			resp.Diagnostics.Append(req.State.Get(ctx, previous)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		branch, permissionPolicy, err = r.generatePermissionPolicy(&data, previous)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Permission Policy", fmt.Sprintf("Could not build Permission policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, permissionPolicy, branch, &resp.Diagnostics)
+}
+
 func (r *ConjurPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
@@ -167,7 +233,7 @@ func (r *ConjurPermissionResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	branch, permissionPolicy, err := r.generatePermissionPolicy(&data)
+	branch, permissionPolicy, err := r.generatePermissionPolicy(&data, nil)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Building Permission Policy", fmt.Sprintf("Could not build Permission policy: %s", err))
 		return
@@ -192,27 +258,45 @@ func (r *ConjurPermissionResource) Read(ctx context.Context, req resource.ReadRe
 	var data ConjurPermissionResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
-	// for each supported privilege, check if it exists and update the state accordingly
-	// TODO: think about if this causes unexpected behavior for inherited privileges
-	// also custom privileges?
-	privs := []string{"read", "update", "execute", "create"}
-	rolePrivs := make([]attr.Value, 0, len(privs))
-
-	for _, priv := range privs {
-		hasPriv, err := r.client.CheckPermissionForRole(
-			fmt.Sprintf("%s:%s", data.Resource.Kind.ValueString(), joinConjurID(data.Resource.Branch.ValueString(), data.Resource.Name.ValueString())),
-			fmt.Sprintf("%s:%s", data.Role.Kind.ValueString(), joinConjurID(data.Role.Branch.ValueString(), data.Role.Name.ValueString())),
-			priv,
-		)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error",
-				fmt.Sprintf("Unable to check permission via API, got error: %s", err))
-			return
-		}
-		if hasPriv {
+	// Imported permissions have no authoritative value yet
+	if data.Authoritative.IsNull() {
+		data.Authoritative = types.BoolValue(true)
+	}
+
+	// Discover the privileges granted directly to the role from the permissions of the resource
+	resourceID := fmt.Sprintf("%s:%s", data.Resource.Kind.ValueString(), conjurRecordID(data.Resource.Kind.ValueString(), data.Resource.Branch.ValueString(), data.Resource.Name.ValueString()))
+	conjurResource, err := sharedResourceCache.get(r.client, resourceID)
+	if isNotFoundErr(err) {
+		resp.Diagnostics.AddWarning("Resource Not Found", fmt.Sprintf("The resource %q was not found in Conjur and the permission will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the resource exists and can be managed by the provider identity.", resourceID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error",
+			fmt.Sprintf("Unable to check permission via API, got error: %s", err))
+		return
+	}
+
+	roleKind := data.Role.Kind.ValueString()
+	roleID := conjurRecordID(roleKind, data.Role.Branch.ValueString(), data.Role.Name.ValueString())
+	granted := rolePrivilegesOnResource(conjurResource, roleKind, roleID)
+
+	// Keep the configured order so that only real changes show up as drift
+	managed, _, _ := parsePrivileges(&data)
+	rolePrivs := make([]attr.Value, 0, len(granted))
+	for _, priv := range managed {
+		if slices.Contains(granted, priv) {
 			rolePrivs = append(rolePrivs, types.StringValue(priv))
 		}
 	}
+	// Non-authoritative permissions only report drift for the privileges they manage
+	if isAuthoritative(&data) {
+		for _, priv := range granted {
+			if !slices.Contains(managed, priv) {
+				rolePrivs = append(rolePrivs, types.StringValue(priv))
+			}
+		}
+	}
 
 	data.Privileges = types.ListValueMust(types.StringType, rolePrivs)
 
@@ -228,7 +312,14 @@ func (r *ConjurPermissionResource) Update(ctx context.Context, req resource.Upda
 	var data ConjurPermissionResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
-	branch, permissionPolicy, err := r.generatePermissionPolicy(&data)
+	// Privileges removed from the previous state are revoked, which covers custom and non-authoritative privileges
+	var previous ConjurPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &previous)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, permissionPolicy, err := r.generatePermissionPolicy(&data, &previous)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Building Permission Policy", fmt.Sprintf("Could not build Permission policy: %s", err))
 		return
@@ -334,12 +425,28 @@ func (r *ConjurPermissionResource) ImportState(ctx context.Context, req resource
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("resource"), resourceBlock)...)
 }
 
-// generatePermissionPolicy creates a policy that grants privileges explicitly added via the resource data, and denies all others
-func (r *ConjurPermissionResource) generatePermissionPolicy(data *ConjurPermissionResourceModel) (string, string, error) {
+// generatePermissionPolicy creates a policy that grants privileges explicitly added via the resource data, and denies all others.
+// Non-authoritative permissions only deny the privileges of the previous state that are no longer listed.
+func (r *ConjurPermissionResource) generatePermissionPolicy(data *ConjurPermissionResourceModel, previous *ConjurPermissionResourceModel) (string, string, error) {
 	granted, notGranted, err := parsePrivileges(data)
 	if err != nil {
 		return "", "", err
 	}
+	if !isAuthoritative(data) {
+		notGranted = []string{}
+	}
+	// Custom privileges aren't part of the standard set, so they are only denied once removed from the previous state
+	if previous != nil {
+		previouslyGranted, _, err := parsePrivileges(previous)
+		if err != nil {
+			return "", "", err
+		}
+		for _, priv := range previouslyGranted {
+			if !slices.Contains(granted, priv) && !slices.Contains(notGranted, priv) {
+				notGranted = append(notGranted, priv)
+			}
+		}
+	}
 
 	roleKind, resKind, err := validateKinds(data)
 	if err != nil {
@@ -349,16 +456,18 @@ func (r *ConjurPermissionResource) generatePermissionPolicy(data *ConjurPermissi
 	branch, roleID, resourceID := derivePolicyContext(data)
 
 	policy := conjurpolicy.PolicyStatements{
-		conjurpolicy.Permit{
-			Role:       conjurpolicy.ResourceRef{Kind: roleKind, Id: roleID},
-			Resources:  conjurpolicy.ResourceRef{Kind: resKind, Id: resourceID},
+		policyPermit{
+			Role:       policyRef{Kind: roleKind, Id: roleID},
+			Resources:  policyRef{Kind: resKind, Id: resourceID},
 			Privileges: granted,
 		},
-		conjurpolicy.Deny{
-			Role:       conjurpolicy.ResourceRef{Kind: roleKind, Id: roleID},
-			Resources:  conjurpolicy.ResourceRef{Kind: resKind, Id: resourceID},
+	}
+	if isAuthoritative(data) || len(notGranted) > 0 {
+		policy = append(policy, policyDeny{
+			Role:       policyRef{Kind: roleKind, Id: roleID},
+			Resources:  policyRef{Kind: resKind, Id: resourceID},
 			Privileges: notGranted,
-		},
+		})
 	}
 
 	yamlBytes, err := yaml.Marshal(policy)
@@ -383,9 +492,9 @@ func (r *ConjurPermissionResource) generatePermissionDenyPolicy(data *ConjurPerm
 	branch, roleID, resourceID := derivePolicyContext(data)
 
 	policy := conjurpolicy.PolicyStatements{
-		conjurpolicy.Deny{
-			Role:       conjurpolicy.ResourceRef{Kind: roleKind, Id: roleID},
-			Resources:  conjurpolicy.ResourceRef{Kind: resKind, Id: resourceID},
+		policyDeny{
+			Role:       policyRef{Kind: roleKind, Id: roleID},
+			Resources:  policyRef{Kind: resKind, Id: resourceID},
 			Privileges: granted,
 		},
 	}
@@ -397,28 +506,59 @@ func (r *ConjurPermissionResource) generatePermissionDenyPolicy(data *ConjurPerm
 	return branch, string(yamlBytes), nil
 }
 
-// parsePrivileges returns a list of granted and not-granted privileges
-func parsePrivileges(data *ConjurPermissionResourceModel) ([]conjurpolicy.Privilege, []conjurpolicy.Privilege, error) {
-	privilegeMap := map[string]conjurpolicy.Privilege{
-		"read":    conjurpolicy.PrivilegeRead,
-		"update":  conjurpolicy.PrivilegeUpdate,
-		"execute": conjurpolicy.PrivilegeExecute,
-		"create":  conjurpolicy.PrivilegeCreate,
+// isAuthoritative reports whether a permission manages every privilege of the role on the resource, which is the default
+func isAuthoritative(data *ConjurPermissionResourceModel) bool {
+	return data.Authoritative.IsNull() || data.Authoritative.IsUnknown() || data.Authoritative.ValueBool()
+}
+
+// resourcePrivileges returns the privileges that can be granted on a resource kind
+func resourcePrivileges(kind string) []string {
+	switch kind {
+	case "webservice":
+		return []string{"read", "authenticate", "execute"}
+	default:
+		return []string{"read", "update", "execute", "create"}
 	}
+}
+
+// rolePrivilegesOnResource returns the sorted privileges granted directly to a role in the permissions of a resource
+func rolePrivilegesOnResource(conjurResource map[string]interface{}, roleKind, roleID string) []string {
+	permissions, _ := conjurResource["permissions"].([]interface{})
 
+	privileges := []string{}
+	for _, p := range permissions {
+		permission, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		role, _ := permission["role"].(string)
+		kind, id, err := splitFullyQualifiedID(role)
+		if err != nil || kind != roleKind || id != roleID {
+			continue
+		}
+		privilege, _ := permission["privilege"].(string)
+		if privilege != "" && !slices.Contains(privileges, privilege) {
+			privileges = append(privileges, privilege)
+		}
+	}
+	slices.Sort(privileges)
+	return privileges
+}
+
+// parsePrivileges returns a list of granted and not-granted privileges
+func parsePrivileges(data *ConjurPermissionResourceModel) ([]string, []string, error) {
 	// Determine granted privileges
-	privList := []conjurpolicy.Privilege{}
+	privList := []string{}
 	for _, priv := range data.Privileges.Elements() {
-		key := priv.(types.String).ValueString()
-		privObj := privilegeMap[key] // Safe because ValidateConfig ensures valid privileges
-		privList = append(privList, privObj)
+		// Safe because ValidateConfig ensures valid privileges
+		privList = append(privList, strings.ToLower(strings.TrimSpace(priv.(types.String).ValueString())))
 	}
 
 	// Determine non-granted privileges
-	denyList := []conjurpolicy.Privilege{}
-	for _, privObj := range privilegeMap {
-		if !slices.Contains(privList, privObj) {
-			denyList = append(denyList, privObj)
+	denyList := []string{}
+	for _, priv := range resourcePrivileges(data.Resource.Kind.ValueString()) {
+		if !slices.Contains(privList, priv) {
+			denyList = append(denyList, priv)
 		}
 	}
 
@@ -426,36 +566,47 @@ func parsePrivileges(data *ConjurPermissionResourceModel) ([]conjurpolicy.Privil
 }
 
 // validateKinds returns the resolved kinds for role and resource
-func validateKinds(data *ConjurPermissionResourceModel) (conjurpolicy.Kind, conjurpolicy.Kind, error) {
-	kindMap := map[string]conjurpolicy.Kind{
-		"user":     conjurpolicy.KindUser,
-		"group":    conjurpolicy.KindGroup,
-		"host":     conjurpolicy.KindHost,
-		"layer":    conjurpolicy.KindLayer,
-		"variable": conjurpolicy.KindVariable,
-		"policy":   conjurpolicy.KindPolicy,
-	}
-
-	// Since ValidateConfig already validates kinds, these lookups should always succeed
-	roleKind := kindMap[data.Role.Kind.ValueString()]
-	resKind := kindMap[data.Resource.Kind.ValueString()]
+func validateKinds(data *ConjurPermissionResourceModel) (string, string, error) {
+	roleKind := data.Role.Kind.ValueString()
+	resKind := data.Resource.Kind.ValueString()
+	// ValidateConfig already validates kinds, but the policy can't be generated without them
+	if roleKind == "" || resKind == "" {
+		return "", "", fmt.Errorf("role and resource kinds must be set")
+	}
 	return roleKind, resKind, nil
 }
 
 // derivePolicyContext calculates the lowest shared branch, plus relative role/resource IDs
 func derivePolicyContext(data *ConjurPermissionResourceModel) (branch, roleID, resourceID string) {
+	return relativePolicyIDs(data.Role.Branch.ValueString(), data.Role.Name.ValueString(), data.Resource.Branch.ValueString(), data.Resource.Name.ValueString())
+}
+
+// relativePolicyIDs returns the shared ancestor branch of two records and their IDs relative to it
+func relativePolicyIDs(branchA, nameA, branchB, nameB string) (branch, idA, idB string) {
 	// Find common ancestor branch
-	branch = mergePolicyBranch(data.Role.Branch.ValueString(), data.Resource.Branch.ValueString())
+	branch = mergePolicyBranch(branchA, branchB)
 
 	// Build full IDs
-	fullRoleID := joinConjurID(data.Role.Branch.ValueString(), data.Role.Name.ValueString())
-	fullResourceID := joinConjurID(data.Resource.Branch.ValueString(), data.Resource.Name.ValueString())
+	fullA := joinConjurID(branchA, nameA)
+	fullB := joinConjurID(branchB, nameB)
 
 	// Trim shared ancestor branch to make relative IDs
-	roleID = strings.TrimPrefix(fullRoleID, branch+"/")
-	resourceID = strings.TrimPrefix(fullResourceID, branch+"/")
+	idA = strings.TrimPrefix(fullA, branch+"/")
+	idB = strings.TrimPrefix(fullB, branch+"/")
 
-	return branch, roleID, resourceID
+	return branch, idA, idB
+}
+
+// conjurRecordID returns the ID Conjur assigns to a record declared in a branch. Users outside the root
+// branch are named `<name>@<branch>`, all other records are named `<branch>/<name>`.
+func conjurRecordID(kind, branch, name string) string {
+	if kind == "user" {
+		return conjurUserID(branch, name)
+	}
+	if strings.Trim(branch, "/") == "root" {
+		branch = ""
+	}
+	return joinConjurID(branch, name)
 }
 
 // joinConjurID creates a full Conjur ID by joining branch (if it exists) and name
@@ -501,3 +652,12 @@ func splitConjurID(fullID string) (kind, branch, name string, err error) {
 	}
 	return kind, branch, name, nil
 }
+
+// splitFullyQualifiedID splits a fully qualified Conjur ID (`<account>:<kind>:<id>`) into kind and ID, ignoring the account
+func splitFullyQualifiedID(fqID string) (kind, id string, err error) {
+	parts := strings.SplitN(fqID, ":", 3)
+	if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("invalid fully qualified Secrets Manager ID: %s. Expected account:kind:id", fqID)
+	}
+	return parts[1], parts[2], nil
+}
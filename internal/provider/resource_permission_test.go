@@ -6,6 +6,7 @@ import (
 
 	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
@@ -57,7 +58,7 @@ func TestConjurPermissionResource_generatePermissionPolicy(t *testing.T) {
 			),
 		}
 
-		branch, permissionPolicy, err := r.generatePermissionPolicy(data)
+		branch, permissionPolicy, err := r.generatePermissionPolicy(data, nil)
 
 		require.NoError(t, err)
 		require.NotNil(t, permissionPolicy)
@@ -115,6 +116,218 @@ func TestConjurPermissionResource_generatePermissionDenyPolicy(t *testing.T) {
 	})
 }
 
+func TestConjurPermissionResource_generatePermissionPolicy_Webservice(t *testing.T) {
+	r := &ConjurPermissionResource{}
+
+	data := &ConjurPermissionResourceModel{
+		Role: RoleModel{
+			Name:   types.StringValue("apps"),
+			Kind:   types.StringValue("group"),
+			Branch: types.StringValue("conjur/authn-jwt/my-service"),
+		},
+		Resource: ResourceModel{
+			Name:   types.StringValue("my-service"),
+			Kind:   types.StringValue("webservice"),
+			Branch: types.StringValue("conjur/authn-jwt"),
+		},
+		Privileges: types.ListValueMust(
+			types.StringType,
+			[]attr.Value{
+				types.StringValue("read"),
+				types.StringValue("authenticate"),
+			},
+		),
+	}
+
+	branch, permissionPolicy, err := r.generatePermissionPolicy(data, nil)
+
+	require.NoError(t, err)
+	expected := `- !permit
+  role: !group my-service/apps
+  privileges: [read, authenticate]
+  resource: !webservice my-service
+- !deny
+  role: !group my-service/apps
+  privileges: [execute]
+  resource: !webservice my-service
+`
+	require.Equal(t, expected, permissionPolicy)
+	require.Equal(t, "conjur/authn-jwt", branch)
+}
+
+func TestConjurPermissionResource_generatePermissionPolicy_NonAuthoritative(t *testing.T) {
+	r := &ConjurPermissionResource{}
+
+	permission := func(privs ...string) *ConjurPermissionResourceModel {
+		values := make([]attr.Value, len(privs))
+		for i, p := range privs {
+			values[i] = types.StringValue(p)
+		}
+		return &ConjurPermissionResourceModel{
+			Role: RoleModel{
+				Name:   types.StringValue("developers"),
+				Kind:   types.StringValue("group"),
+				Branch: types.StringValue("data/test"),
+			},
+			Resource: ResourceModel{
+				Name:   types.StringValue("db-password"),
+				Kind:   types.StringValue("variable"),
+				Branch: types.StringValue("data/test"),
+			},
+			Privileges:    types.ListValueMust(types.StringType, values),
+			Authoritative: types.BoolValue(false),
+		}
+	}
+
+	t.Run("Create only permits the listed privileges", func(t *testing.T) {
+		branch, permissionPolicy, err := r.generatePermissionPolicy(permission("read"), nil)
+
+		require.NoError(t, err)
+		require.Equal(t, "data/test", branch)
+		require.Equal(t, "- !permit\n  role: !group developers\n  privileges: [read]\n  resource: !variable db-password\n", permissionPolicy)
+	})
+
+	t.Run("Update denies only the privileges removed from the resource", func(t *testing.T) {
+		_, permissionPolicy, err := r.generatePermissionPolicy(permission("read"), permission("read", "execute"))
+
+		require.NoError(t, err)
+		expected := `- !permit
+  role: !group developers
+  privileges: [read]
+  resource: !variable db-password
+- !deny
+  role: !group developers
+  privileges: [execute]
+  resource: !variable db-password
+`
+		require.Equal(t, expected, permissionPolicy)
+	})
+
+	t.Run("Switching to authoritative denies all other privileges", func(t *testing.T) {
+		data := permission("read")
+		data.Authoritative = types.BoolValue(true)
+
+		_, permissionPolicy, err := r.generatePermissionPolicy(data, permission("read"))
+
+		require.NoError(t, err)
+		require.Contains(t, permissionPolicy, "privileges: [update, execute, create]")
+	})
+}
+
+func TestConjurPermissionResource_generatePermissionPolicy_CustomPrivileges(t *testing.T) {
+	r := &ConjurPermissionResource{}
+
+	permission := func(privs ...string) *ConjurPermissionResourceModel {
+		values := make([]attr.Value, len(privs))
+		for i, p := range privs {
+			values[i] = types.StringValue(p)
+		}
+		return &ConjurPermissionResourceModel{
+			Role: RoleModel{
+				Name:   types.StringValue("ci"),
+				Kind:   types.StringValue("host"),
+				Branch: types.StringValue("data/apps"),
+			},
+			Resource: ResourceModel{
+				Name:   types.StringValue("deployer"),
+				Kind:   types.StringValue("webservice"),
+				Branch: types.StringValue("data/apps"),
+			},
+			Privileges: types.ListValueMust(types.StringType, values),
+		}
+	}
+
+	_, permissionPolicy, err := r.generatePermissionPolicy(permission("read", "deploy"), permission("read", "deploy", "rollback"))
+
+	require.NoError(t, err)
+	expected := `- !permit
+  role: !host ci
+  privileges: [read, deploy]
+  resource: !webservice deployer
+- !deny
+  role: !host ci
+  privileges: [authenticate, execute, rollback]
+  resource: !webservice deployer
+`
+	require.Equal(t, expected, permissionPolicy)
+}
+
+func TestValidatePrivileges_Custom(t *testing.T) {
+	privileges := func(privs ...string) types.List {
+		values := make([]attr.Value, len(privs))
+		for i, p := range privs {
+			values[i] = types.StringValue(p)
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name       string
+		privileges types.List
+		wantError  bool
+	}{
+		{name: "standard privileges", privileges: privileges("read", "execute")},
+		{name: "custom privileges", privileges: privileges("use_host_factory", "deploy")},
+		{name: "unknown privilege", privileges: types.ListValueMust(types.StringType, []attr.Value{types.StringUnknown()})},
+		{name: "empty privilege", privileges: privileges("read", " "), wantError: true},
+		{name: "privilege with whitespace", privileges: privileges("read execute"), wantError: true},
+		{name: "no privileges", privileges: privileges(), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			ValidatePrivileges(tt.privileges, &diags, "privileges", nil)
+			assert.Equal(t, tt.wantError, diags.HasError(), "%+v", diags)
+		})
+	}
+}
+
+func TestRolePrivilegesOnResource(t *testing.T) {
+	conjurResource := map[string]interface{}{
+		"permissions": []interface{}{
+			map[string]interface{}{"privilege": "read", "role": "myaccount:host:data/apps/ci"},
+			map[string]interface{}{"privilege": "deploy", "role": "myaccount:host:data/apps/ci"},
+			map[string]interface{}{"privilege": "read", "role": "myaccount:group:data/apps/ci"},
+			map[string]interface{}{"privilege": "execute", "role": "myaccount:host:data/apps/other"},
+			"malformed",
+		},
+	}
+
+	assert.Equal(t, []string{"deploy", "read"}, rolePrivilegesOnResource(conjurResource, "host", "data/apps/ci"))
+	assert.Equal(t, []string{}, rolePrivilegesOnResource(map[string]interface{}{}, "host", "data/apps/ci"))
+}
+
+func TestValidatePrivileges_ResourceKind(t *testing.T) {
+	privileges := func(privs ...string) types.List {
+		values := make([]attr.Value, len(privs))
+		for i, p := range privs {
+			values[i] = types.StringValue(p)
+		}
+		return types.ListValueMust(types.StringType, values)
+	}
+
+	tests := []struct {
+		name       string
+		kind       string
+		privileges types.List
+		wantError  bool
+	}{
+		{name: "authenticate on webservice", kind: "webservice", privileges: privileges("read", "authenticate")},
+		{name: "update on webservice", kind: "webservice", privileges: privileges("update"), wantError: true},
+		{name: "authenticate on variable", kind: "variable", privileges: privileges("authenticate"), wantError: true},
+		{name: "create on policy", kind: "policy", privileges: privileges("create")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			ValidatePrivileges(tt.privileges, &diags, "privileges", resourcePrivileges(tt.kind))
+			assert.Equal(t, tt.wantError, diags.HasError(), "%+v", diags)
+		})
+	}
+}
+
 func TestDerivePolicyContext(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -395,7 +608,7 @@ func TestGeneratePermissionPolicy_YAMLInjection(t *testing.T) {
 				),
 			}
 
-			branch, policy, err := r.generatePermissionPolicy(data)
+			branch, policy, err := r.generatePermissionPolicy(data, nil)
 			require.NoError(t, err, "Policy generation should not fail for: %s", tc.description)
 			require.NotEmpty(t, branch, "Branch should not be empty")
 
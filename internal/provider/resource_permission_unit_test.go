@@ -206,10 +206,10 @@ func TestPermissionResource_Read(t *testing.T) {
 				}),
 			},
 			setupMock: func(mockV2 *mocks.MockClientV2) {
-				mockV2.On("CheckPermissionForRole", "variable:data/test/db-password", "group:data/test/developers", "read").Return(true, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/test/db-password", "group:data/test/developers", "update").Return(false, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/test/db-password", "group:data/test/developers", "execute").Return(false, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/test/db-password", "group:data/test/developers", "create").Return(false, nil)
+				mockV2.On("Resource", "variable:data/test/db-password").Return(testResourcePermissions(
+					"myaccount:group:data/test/developers", "read",
+					"myaccount:group:data/test/admins", "update",
+				), nil)
 			},
 			expectedError: false,
 			expectedPrivs: []string{"read"},
@@ -233,10 +233,10 @@ func TestPermissionResource_Read(t *testing.T) {
 				}),
 			},
 			setupMock: func(mockV2 *mocks.MockClientV2) {
-				mockV2.On("CheckPermissionForRole", "variable:data/prod/api-key", "group:data/prod/admins", "read").Return(true, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/prod/api-key", "group:data/prod/admins", "update").Return(true, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/prod/api-key", "group:data/prod/admins", "execute").Return(false, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/prod/api-key", "group:data/prod/admins", "create").Return(false, nil)
+				mockV2.On("Resource", "variable:data/prod/api-key").Return(testResourcePermissions(
+					"myaccount:group:data/prod/admins", "update",
+					"myaccount:group:data/prod/admins", "read",
+				), nil)
 			},
 			expectedError: false,
 			expectedPrivs: []string{"read", "update"},
@@ -259,7 +259,7 @@ func TestPermissionResource_Read(t *testing.T) {
 				}),
 			},
 			setupMock: func(mockV2 *mocks.MockClientV2) {
-				mockV2.On("CheckPermissionForRole", "variable:data/test/secret", "group:data/test/users", "read").Return(false, fmt.Errorf("connection error"))
+				mockV2.On("Resource", "variable:data/test/secret").Return(nil, fmt.Errorf("connection error"))
 			},
 			expectedError: true,
 			errorContains: "Unable to check permission via API",
@@ -280,14 +280,39 @@ func TestPermissionResource_Read(t *testing.T) {
 				Privileges: types.ListValueMust(types.StringType, []attr.Value{}),
 			},
 			setupMock: func(mockV2 *mocks.MockClientV2) {
-				mockV2.On("CheckPermissionForRole", "variable:data/test/restricted", "group:data/test/guests", "read").Return(false, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/test/restricted", "group:data/test/guests", "update").Return(false, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/test/restricted", "group:data/test/guests", "execute").Return(false, nil)
-				mockV2.On("CheckPermissionForRole", "variable:data/test/restricted", "group:data/test/guests", "create").Return(false, nil)
+				mockV2.On("Resource", "variable:data/test/restricted").Return(testResourcePermissions(), nil)
 			},
 			expectedError: false,
 			expectedPrivs: []string{},
 		},
+		{
+			name: "custom privileges granted outside of Terraform",
+			data: ConjurPermissionResourceModel{
+				Role: RoleModel{
+					Name:   types.StringValue("alice"),
+					Kind:   types.StringValue("user"),
+					Branch: types.StringValue("data/test"),
+				},
+				Resource: ResourceModel{
+					Name:   types.StringValue("my-service"),
+					Kind:   types.StringValue("webservice"),
+					Branch: types.StringValue("data/test"),
+				},
+				Privileges: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("read"),
+					types.StringValue("authenticate"),
+				}),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "webservice:data/test/my-service").Return(testResourcePermissions(
+					"myaccount:user:alice@data-test", "authenticate",
+					"myaccount:user:alice@data-test", "read",
+					"myaccount:user:alice@data-test", "use_service",
+				), nil)
+			},
+			expectedError: false,
+			expectedPrivs: []string{"read", "authenticate", "use_service"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -334,7 +359,9 @@ func TestPermissionResource_Read(t *testing.T) {
 				var result ConjurPermissionResourceModel
 				resp.State.Get(ctx, &result)
 
-				assert.Equal(t, len(tt.expectedPrivs), len(result.Privileges.Elements()))
+				var privileges []string
+				result.Privileges.ElementsAs(ctx, &privileges, false)
+				assert.Equal(t, tt.expectedPrivs, privileges)
 			}
 
 			mockV2.AssertExpectations(t)
@@ -443,6 +470,10 @@ func TestPermissionResource_Update(t *testing.T) {
 					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
 					Schema: getPermissionTestSchema(),
 				},
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPermissionTestSchema(),
+				},
 			}
 			resp := &resource.UpdateResponse{
 				State: tfsdk.State{
@@ -453,6 +484,7 @@ func TestPermissionResource_Update(t *testing.T) {
 
 			ctx := context.Background()
 			req.Plan.Set(ctx, &tt.data)
+			req.State.Set(ctx, &tt.data)
 
 			r.Update(ctx, req, resp)
 
@@ -476,6 +508,122 @@ func TestPermissionResource_Update(t *testing.T) {
 	}
 }
 
+func TestPermissionResource_Read_ResourceNotFound(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("Resource", "variable:data/test/db-password").Return(nil, fmt.Errorf("404 Not Found"))
+
+	r := &ConjurPermissionResource{client: mockV2}
+
+	ctx := context.Background()
+	req := resource.ReadRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+	}
+	resp := &resource.ReadResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+	}
+	data := ConjurPermissionResourceModel{
+		Role: RoleModel{
+			Name:   types.StringValue("developers"),
+			Kind:   types.StringValue("group"),
+			Branch: types.StringValue("data/test"),
+		},
+		Resource: ResourceModel{
+			Name:   types.StringValue("db-password"),
+			Kind:   types.StringValue("variable"),
+			Branch: types.StringValue("data/test"),
+		},
+		Privileges: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("read")}),
+	}
+	req.State.Set(ctx, &data)
+
+	r.Read(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull())
+	mockV2.AssertExpectations(t)
+}
+
+func TestPermissionResource_NonAuthoritative(t *testing.T) {
+	permission := func(privs ...string) ConjurPermissionResourceModel {
+		values := make([]attr.Value, len(privs))
+		for i, p := range privs {
+			values[i] = types.StringValue(p)
+		}
+		return ConjurPermissionResourceModel{
+			Role: RoleModel{
+				Name:   types.StringValue("developers"),
+				Kind:   types.StringValue("group"),
+				Branch: types.StringValue("data/test"),
+			},
+			Resource: ResourceModel{
+				Name:   types.StringValue("db-password"),
+				Kind:   types.StringValue("variable"),
+				Branch: types.StringValue("data/test"),
+			},
+			Privileges:    types.ListValueMust(types.StringType, values),
+			Authoritative: types.BoolValue(false),
+		}
+	}
+	ctx := context.Background()
+
+	t.Run("Read only checks managed privileges", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("Resource", "variable:data/test/db-password").Return(testResourcePermissions(
+			"myaccount:group:data/test/developers", "read",
+			"myaccount:group:data/test/developers", "update",
+		), nil)
+
+		r := &ConjurPermissionResource{client: mockV2}
+
+		req := resource.ReadRequest{
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+		}
+		resp := &resource.ReadResponse{
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+		}
+		data := permission("read", "execute")
+		req.State.Set(ctx, &data)
+
+		r.Read(ctx, req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError())
+		var result ConjurPermissionResourceModel
+		resp.State.Get(ctx, &result)
+		assert.Equal(t, types.ListValueMust(types.StringType, []attr.Value{types.StringValue("read")}), result.Privileges)
+		assert.False(t, result.Authoritative.ValueBool())
+		mockV2.AssertExpectations(t)
+	})
+
+	t.Run("Update revokes removed privileges only", func(t *testing.T) {
+		mockV2 := mocks.NewMockClientV2(t)
+		mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/test", mock.MatchedBy(func(policy io.Reader) bool {
+			buf := new(strings.Builder)
+			_, _ = io.Copy(buf, policy)
+			content := buf.String()
+			return contains(content, "privileges: [read]") && contains(content, "privileges: [execute]") && !contains(content, "update")
+		})).Return(&conjurapi.PolicyResponse{}, nil)
+
+		r := &ConjurPermissionResource{client: mockV2}
+
+		req := resource.UpdateRequest{
+			Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+		}
+		resp := &resource.UpdateResponse{
+			State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionTestSchema()},
+		}
+		plan := permission("read")
+		state := permission("read", "execute")
+		req.Plan.Set(ctx, &plan)
+		req.State.Set(ctx, &state)
+
+		r.Update(ctx, req, resp)
+
+		assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+		mockV2.AssertExpectations(t)
+	})
+}
+
 func TestPermissionResource_Delete(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -614,3 +762,19 @@ func getPermissionTestSchema() schema.Schema {
 	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
 	return schemaResp.Schema
 }
+
+// testResourcePermissions returns a resource response holding the given pairs of fully qualified role IDs and privileges
+func testResourcePermissions(rolePrivileges ...string) map[string]interface{} {
+	permissions := []interface{}{}
+	for i := 0; i+1 < len(rolePrivileges); i += 2 {
+		permissions = append(permissions, map[string]interface{}{
+			"role":      rolePrivileges[i],
+			"privilege": rolePrivileges[i+1],
+			"policy":    "myaccount:policy:data",
+		})
+	}
+	return map[string]interface{}{
+		"id":          "myaccount:variable:data/test/db-password",
+		"permissions": permissions,
+	}
+}
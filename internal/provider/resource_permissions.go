@@ -0,0 +1,474 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurPermissionsResource{}
+	_ resource.ResourceWithConfigure      = &ConjurPermissionsResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurPermissionsResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurPermissionsResource{}
+)
+
+func NewConjurPermissionsResource() resource.Resource {
+	return &ConjurPermissionsResource{}
+}
+
+// ConjurPermissionsResource defines the resource implementation.
+type ConjurPermissionsResource struct {
+	client api.ClientV2
+}
+
+// ConjurPermissionsResourceModel describes the resource data model.
+type ConjurPermissionsResourceModel struct {
+	Roles      types.Set  `tfsdk:"roles"`
+	Resources  types.Set  `tfsdk:"resources"`
+	Privileges types.List `tfsdk:"privileges"`
+}
+
+// permissionRecordObjectType is the type of the role and resource set elements
+var permissionRecordObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":   types.StringType,
+		"kind":   types.StringType,
+		"branch": types.StringType,
+	},
+}
+
+// permissionsSpec holds the roles, resources and privileges of a conjur_permissions resource
+type permissionsSpec struct {
+	roles      []RoleModel
+	resources  []RoleModel
+	privileges []string
+}
+
+func (r *ConjurPermissionsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permissions"
+}
+
+func (r *ConjurPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recordAttributes := func(record string) map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The name of the %s", record),
+				Required:            true,
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The kind of the %s", record),
+				Required:            true,
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The policy branch of the %s", record),
+				Required:            true,
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager bulk permission resource. This resource permits the same privileges to every role on every resource listed " +
+			"using a single policy load. Privileges are only revoked when they, a role or a resource are removed, other privileges on the same records are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.SetNestedAttribute{
+				MarkdownDescription: "The roles to permit the privileges to",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordAttributes("role"),
+				},
+			},
+			"resources": schema.SetNestedAttribute{
+				MarkdownDescription: "The resources to permit the privileges on",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordAttributes("resource"),
+				},
+			},
+			"privileges": schema.ListAttribute{
+				MarkdownDescription: "List of privileges to permit on every resource, e.g. `read` and `execute`",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ConjurPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurPermissionsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateRecords := func(records types.Set, field string, kinds []string) {
+		if records.IsNull() || records.IsUnknown() {
+			return
+		}
+		var models []RoleModel
+		resp.Diagnostics.Append(records.ElementsAs(ctx, &models, false)...)
+		if len(models) == 0 {
+			resp.Diagnostics.AddError("Invalid value", fmt.Sprintf("At least one element must be specified in %s.", field))
+		}
+		for _, m := range models {
+			ValidateNonEmpty(m.Name, &resp.Diagnostics, field+" name")
+			ValidateContainedIn(m.Kind, &resp.Diagnostics, field+" kind", kinds, false)
+			if !m.Branch.IsUnknown() {
+				ValidateBranch(m.Branch, &resp.Diagnostics, field+" branch")
+			}
+		}
+	}
+	validateRecords(data.Roles, "roles", permissionRoleKinds)
+	validateRecords(data.Resources, "resources", permissionResourceKinds)
+
+	ValidatePrivileges(data.Privileges, &resp.Diagnostics, "privileges", nil)
+}
+
+func (r *ConjurPermissionsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurPermissionsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var branch, permissionsPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		var state ConjurPermissionsResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		current := permissionsFromModel(ctx, &state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branch, permissionsPolicy, err = generatePermissionsDenyPolicy(current)
+	} else {
+		var data ConjurPermissionsResourceModel
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		desired := permissionsFromModel(ctx, &data, &resp.Diagnostics)
+		var previous *permissionsSpec
+		if !req.State.Raw.IsNull() {
+			var state ConjurPermissionsResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+			current := permissionsFromModel(ctx, &state, &resp.Diagnostics)
+			previous = &current
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		branch, permissionsPolicy, err = generatePermissionsPolicy(desired, previous)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Permissions Policy", fmt.Sprintf("Could not build Permissions policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, permissionsPolicy, branch, &resp.Diagnostics)
+}
+
+func (r *ConjurPermissionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	desired := permissionsFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, permissionsPolicy, err := generatePermissionsPolicy(desired, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Permissions Policy", fmt.Sprintf("Could not build Permissions policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, permissionsPolicy, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load Permissions policy, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created permissions resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	current := permissionsFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Look up each resource once and check the privileges of every role in its permissions
+	missing := map[string]bool{}
+	resources := make([]RoleModel, 0, len(current.resources))
+	for _, res := range current.resources {
+		resourceID := fmt.Sprintf("%s:%s", res.Kind.ValueString(), conjurRecordID(res.Kind.ValueString(), res.Branch.ValueString(), res.Name.ValueString()))
+		conjurResource, err := sharedResourceCache.get(r.client, resourceID)
+		if isNotFoundErr(err) {
+			resp.Diagnostics.AddWarning("Resource Not Found", fmt.Sprintf("The resource %q was not found in Conjur and will be removed from the state.", resourceID))
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permissions of %q via API, got error: %s", resourceID, err))
+			return
+		}
+		resources = append(resources, res)
+
+		for _, role := range current.roles {
+			roleKind := role.Kind.ValueString()
+			granted := rolePrivilegesOnResource(conjurResource, roleKind, conjurRecordID(roleKind, role.Branch.ValueString(), role.Name.ValueString()))
+			for _, priv := range current.privileges {
+				if !slices.Contains(granted, priv) {
+					missing[priv] = true
+				}
+			}
+		}
+	}
+
+	// A privilege missing from any role and resource pair is reported as drift so the next apply permits it again
+	privileges := make([]attr.Value, 0, len(current.privileges))
+	for _, priv := range current.privileges {
+		if !missing[priv] {
+			privileges = append(privileges, types.StringValue(priv))
+		}
+	}
+	data.Privileges = types.ListValueMust(types.StringType, privileges)
+
+	if len(resources) != len(current.resources) {
+		resourceSet, diags := types.SetValueFrom(ctx, permissionRecordObjectType, resources)
+		resp.Diagnostics.Append(diags...)
+		data.Resources = resourceSet
+	}
+
+	tflog.Trace(ctx, "read permissions resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurPermissionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state ConjurPermissionsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	desired := permissionsFromModel(ctx, &data, &resp.Diagnostics)
+	current := permissionsFromModel(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, permissionsPolicy, err := generatePermissionsPolicy(desired, &current)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Permissions Policy", fmt.Sprintf("Could not build Permissions policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, permissionsPolicy, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load Permissions policy, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated permissions resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurPermissionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurPermissionsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	current := permissionsFromModel(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	branch, permissionsPolicy, err := generatePermissionsDenyPolicy(current)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Permissions Delete Policy", fmt.Sprintf("Could not build Permissions Delete policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, permissionsPolicy, branch)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to load Permissions policy, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted permissions resource")
+}
+
+// permissionsFromModel reads the roles, resources and privileges of a conjur_permissions resource
+func permissionsFromModel(ctx context.Context, data *ConjurPermissionsResourceModel, diags *diag.Diagnostics) permissionsSpec {
+	var spec permissionsSpec
+	if !data.Roles.IsNull() && !data.Roles.IsUnknown() {
+		diags.Append(data.Roles.ElementsAs(ctx, &spec.roles, false)...)
+	}
+	if !data.Resources.IsNull() && !data.Resources.IsUnknown() {
+		diags.Append(data.Resources.ElementsAs(ctx, &spec.resources, false)...)
+	}
+	spec.privileges = normalizePrivileges(data.Privileges)
+	return spec
+}
+
+// normalizePrivileges returns the lower-cased privileges of a list without duplicates, in their configured order
+func normalizePrivileges(list types.List) []string {
+	var privileges []string
+	for _, priv := range list.Elements() {
+		privilege := strings.ToLower(strings.TrimSpace(priv.(types.String).ValueString()))
+		if !slices.Contains(privileges, privilege) {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
+// generatePermissionsPolicy creates a single policy permitting the privileges to every role on every resource.
+// Privileges, roles and resources removed since the previous state are denied in the same policy.
+func generatePermissionsPolicy(desired permissionsSpec, previous *permissionsSpec) (string, string, error) {
+	records := [][]RoleModel{desired.roles, desired.resources}
+	if previous != nil {
+		records = append(records, previous.roles, previous.resources)
+	}
+	branch := sharedRecordBranch(records...)
+
+	var statements conjurpolicy.PolicyStatements
+	if len(desired.roles) > 0 && len(desired.resources) > 0 && len(desired.privileges) > 0 {
+		statements = append(statements, policyPermits{
+			Role:       relativeRecordRefs(branch, desired.roles),
+			Privileges: desired.privileges,
+			Resources:  relativeRecordRefs(branch, desired.resources),
+		})
+	}
+
+	if previous != nil {
+		removedPrivileges := slices.DeleteFunc(slices.Clone(previous.privileges), func(priv string) bool {
+			return slices.Contains(desired.privileges, priv)
+		})
+		removedRoles := removedRecords(previous.roles, desired.roles)
+		removedResources := removedRecords(previous.resources, desired.resources)
+
+		denies := []policyDenies{
+			{Role: relativeRecordRefs(branch, previous.roles), Privileges: removedPrivileges, Resources: relativeRecordRefs(branch, previous.resources)},
+			{Role: relativeRecordRefs(branch, removedRoles), Privileges: previous.privileges, Resources: relativeRecordRefs(branch, previous.resources)},
+			{Role: relativeRecordRefs(branch, previous.roles), Privileges: previous.privileges, Resources: relativeRecordRefs(branch, removedResources)},
+		}
+		for _, deny := range denies {
+			if len(deny.Role) > 0 && len(deny.Privileges) > 0 && len(deny.Resources) > 0 {
+				statements = append(statements, deny)
+			}
+		}
+	}
+
+	if len(statements) == 0 {
+		return "", "", fmt.Errorf("at least one role, resource and privilege must be set")
+	}
+
+	yamlBytes, err := yaml.Marshal(statements)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal policy: %w", err)
+	}
+	return branch, string(yamlBytes), nil
+}
+
+// generatePermissionsDenyPolicy creates a policy that denies the privileges to every role on every resource
+func generatePermissionsDenyPolicy(current permissionsSpec) (string, string, error) {
+	if len(current.roles) == 0 || len(current.resources) == 0 || len(current.privileges) == 0 {
+		return "", "", fmt.Errorf("at least one role, resource and privilege must be set")
+	}
+
+	branch := sharedRecordBranch(current.roles, current.resources)
+	statements := conjurpolicy.PolicyStatements{
+		policyDenies{
+			Role:       relativeRecordRefs(branch, current.roles),
+			Privileges: current.privileges,
+			Resources:  relativeRecordRefs(branch, current.resources),
+		},
+	}
+
+	yamlBytes, err := yaml.Marshal(statements)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal policy: %w", err)
+	}
+	return branch, string(yamlBytes), nil
+}
+
+// sharedRecordBranch determines the lowest-level policy branch shared by all records
+func sharedRecordBranch(records ...[]RoleModel) string {
+	var branch string
+	first := true
+	for _, group := range records {
+		for _, record := range group {
+			if first {
+				branch = strings.Trim(record.Branch.ValueString(), "/")
+				first = false
+				continue
+			}
+			branch = mergePolicyBranch(branch, record.Branch.ValueString())
+		}
+	}
+	return branch
+}
+
+// relativeRecordRefs returns policy references to records relative to the branch the policy is loaded into, sorted by kind and ID
+func relativeRecordRefs(branch string, records []RoleModel) []policyRef {
+	refs := make([]policyRef, 0, len(records))
+	for _, record := range records {
+		refs = append(refs, policyRef{
+			Kind: record.Kind.ValueString(),
+			Id:   strings.TrimPrefix(joinConjurID(record.Branch.ValueString(), record.Name.ValueString()), branch+"/"),
+		})
+	}
+	slices.SortFunc(refs, func(a, b policyRef) int {
+		if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+	return refs
+}
+
+// removedRecords returns the previous records that are no longer desired
+func removedRecords(previous, desired []RoleModel) []RoleModel {
+	var removed []RoleModel
+	for _, record := range previous {
+		if !slices.Contains(desired, record) {
+			removed = append(removed, record)
+		}
+	}
+	return removed
+}
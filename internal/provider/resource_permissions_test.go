@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurPermissionsResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewConjurPermissionsResource().Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func testPermissionRecord(kind, branch, name string) RoleModel {
+	return RoleModel{
+		Name:   types.StringValue(name),
+		Kind:   types.StringValue(kind),
+		Branch: types.StringValue(branch),
+	}
+}
+
+func testPermissionsModel(t *testing.T, roles, resources []RoleModel, privileges ...string) *ConjurPermissionsResourceModel {
+	ctx := context.Background()
+	roleSet, diags := types.SetValueFrom(ctx, permissionRecordObjectType, roles)
+	require.False(t, diags.HasError(), "%+v", diags)
+	resourceSet, diags := types.SetValueFrom(ctx, permissionRecordObjectType, resources)
+	require.False(t, diags.HasError(), "%+v", diags)
+
+	privilegeValues := make([]attr.Value, 0, len(privileges))
+	for _, priv := range privileges {
+		privilegeValues = append(privilegeValues, types.StringValue(priv))
+	}
+
+	return &ConjurPermissionsResourceModel{
+		Roles:      roleSet,
+		Resources:  resourceSet,
+		Privileges: types.ListValueMust(types.StringType, privilegeValues),
+	}
+}
+
+func testPermissionsSpec(t *testing.T, data *ConjurPermissionsResourceModel) permissionsSpec {
+	var diags diag.Diagnostics
+	spec := permissionsFromModel(context.Background(), data, &diags)
+	require.False(t, diags.HasError(), "%+v", diags)
+	return spec
+}
+
+func TestConjurPermissionsResource_generatePermissionsPolicy(t *testing.T) {
+	roles := []RoleModel{
+		testPermissionRecord("host", "data/apps", "app-1"),
+		testPermissionRecord("group", "data", "readers"),
+	}
+	resources := []RoleModel{
+		testPermissionRecord("variable", "data/secrets", "db-password"),
+		testPermissionRecord("variable", "data/secrets", "db-username"),
+	}
+
+	t.Run("Single permit statement", func(t *testing.T) {
+		desired := testPermissionsSpec(t, testPermissionsModel(t, roles, resources, "Read", "execute", "read"))
+
+		branch, permissionsPolicy, err := generatePermissionsPolicy(desired, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", branch)
+		assert.Equal(t, "- !permit\n"+
+			"  role: [!group readers, !host apps/app-1]\n"+
+			"  privileges: [read, execute]\n"+
+			"  resource: [!variable secrets/db-password, !variable secrets/db-username]\n", permissionsPolicy)
+	})
+
+	t.Run("Removed privileges, roles and resources are denied", func(t *testing.T) {
+		previous := testPermissionsSpec(t, testPermissionsModel(t, roles, resources, "read", "execute"))
+		desired := testPermissionsSpec(t, testPermissionsModel(t, roles[:1], resources[:1], "read"))
+
+		branch, permissionsPolicy, err := generatePermissionsPolicy(desired, &previous)
+
+		require.NoError(t, err)
+		assert.Equal(t, "data", branch)
+		assert.Equal(t, "- !permit\n"+
+			"  role: [!host apps/app-1]\n"+
+			"  privileges: [read]\n"+
+			"  resource: [!variable secrets/db-password]\n"+
+			"- !deny\n"+
+			"  role: [!group readers, !host apps/app-1]\n"+
+			"  privileges: [execute]\n"+
+			"  resource: [!variable secrets/db-password, !variable secrets/db-username]\n"+
+			"- !deny\n"+
+			"  role: [!group readers]\n"+
+			"  privileges: [read, execute]\n"+
+			"  resource: [!variable secrets/db-password, !variable secrets/db-username]\n"+
+			"- !deny\n"+
+			"  role: [!group readers, !host apps/app-1]\n"+
+			"  privileges: [read, execute]\n"+
+			"  resource: [!variable secrets/db-username]\n", permissionsPolicy)
+	})
+
+	t.Run("No changes from previous", func(t *testing.T) {
+		previous := testPermissionsSpec(t, testPermissionsModel(t, roles, resources, "read"))
+
+		_, permissionsPolicy, err := generatePermissionsPolicy(previous, &previous)
+
+		require.NoError(t, err)
+		assert.NotContains(t, permissionsPolicy, "!deny")
+	})
+
+	t.Run("Empty permissions", func(t *testing.T) {
+		desired := testPermissionsSpec(t, testPermissionsModel(t, roles, nil, "read"))
+
+		_, _, err := generatePermissionsPolicy(desired, nil)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestConjurPermissionsResource_generatePermissionsDenyPolicy(t *testing.T) {
+	current := testPermissionsSpec(t, testPermissionsModel(t,
+		[]RoleModel{testPermissionRecord("user", "data/team", "alice")},
+		[]RoleModel{testPermissionRecord("webservice", "data/services", "api")},
+		"read", "authenticate"))
+
+	branch, permissionsPolicy, err := generatePermissionsDenyPolicy(current)
+
+	require.NoError(t, err)
+	assert.Equal(t, "data", branch)
+	assert.Equal(t, "- !deny\n"+
+		"  role: [!user team/alice]\n"+
+		"  privileges: [read, authenticate]\n"+
+		"  resource: [!webservice services/api]\n", permissionsPolicy)
+}
@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func testPermissionsUnitModel(t *testing.T, privileges ...string) *ConjurPermissionsResourceModel {
+	return testPermissionsModel(t,
+		[]RoleModel{
+			testPermissionRecord("host", "data/apps", "app-1"),
+			testPermissionRecord("group", "data", "readers"),
+		},
+		[]RoleModel{
+			testPermissionRecord("variable", "data/secrets", "db-password"),
+			testPermissionRecord("variable", "data/secrets", "db-username"),
+		},
+		privileges...)
+}
+
+func TestPermissionsResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "single policy load",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return strings.Count(buf.String(), "!permit") == 1 &&
+						contains(buf.String(), "role: [!group readers, !host apps/app-1]") &&
+						contains(buf.String(), "privileges: [read, execute]")
+				})).Return(&conjurapi.PolicyResponse{}, nil).Once()
+			},
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(
+					nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Unable to load Permissions policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurPermissionsResource{
+				client: mockV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPermissionsTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPermissionsTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.Plan.Set(ctx, testPermissionsUnitModel(t, "read", "execute"))
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPermissionsResource_Read(t *testing.T) {
+	tests := []struct {
+		name               string
+		setupMock          func(*mocks.MockClientV2)
+		expectedError      bool
+		expectedPrivileges []string
+		expectedResources  int
+	}{
+		{
+			name: "all privileges granted",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				granted := testResourcePermissions(
+					"myaccount:host:data/apps/app-1", "read", "myaccount:host:data/apps/app-1", "execute",
+					"myaccount:group:data/readers", "read", "myaccount:group:data/readers", "execute")
+				mockV2.On("Resource", "variable:data/secrets/db-password").Return(granted, nil)
+				mockV2.On("Resource", "variable:data/secrets/db-username").Return(granted, nil)
+			},
+			expectedPrivileges: []string{"read", "execute"},
+			expectedResources:  2,
+		},
+		{
+			name: "privilege revoked from one role on one resource",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/secrets/db-password").Return(testResourcePermissions(
+					"myaccount:host:data/apps/app-1", "read", "myaccount:host:data/apps/app-1", "execute",
+					"myaccount:group:data/readers", "read", "myaccount:group:data/readers", "execute"), nil)
+				mockV2.On("Resource", "variable:data/secrets/db-username").Return(testResourcePermissions(
+					"myaccount:host:data/apps/app-1", "read", "myaccount:host:data/apps/app-1", "execute",
+					"myaccount:group:data/readers", "read"), nil)
+			},
+			expectedPrivileges: []string{"read"},
+			expectedResources:  2,
+		},
+		{
+			name: "resource deleted outside of Terraform",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/secrets/db-password").Return(testResourcePermissions(
+					"myaccount:host:data/apps/app-1", "read", "myaccount:host:data/apps/app-1", "execute",
+					"myaccount:group:data/readers", "read", "myaccount:group:data/readers", "execute"), nil)
+				mockV2.On("Resource", "variable:data/secrets/db-username").Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectedPrivileges: []string{"read", "execute"},
+			expectedResources:  1,
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/secrets/db-password").Return(nil, fmt.Errorf("connection error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurPermissionsResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPermissionsTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPermissionsTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, testPermissionsUnitModel(t, "read", "execute"))
+
+			r.Read(ctx, req, resp)
+
+			assert.Equal(t, tt.expectedError, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			if !tt.expectedError {
+				var result ConjurPermissionsResourceModel
+				resp.Diagnostics.Append(resp.State.Get(ctx, &result)...)
+				require.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+
+				var privileges []string
+				resp.Diagnostics.Append(result.Privileges.ElementsAs(ctx, &privileges, false)...)
+				assert.Equal(t, tt.expectedPrivileges, privileges)
+				assert.Len(t, result.Resources.Elements(), tt.expectedResources)
+				assert.Len(t, result.Roles.Elements(), 2)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPermissionsResource_Update(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "!permit") && contains(buf.String(), "!deny") && contains(buf.String(), "privileges: [execute]")
+	})).Return(&conjurapi.PolicyResponse{}, nil).Once()
+
+	r := &ConjurPermissionsResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionsTestSchema()},
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionsTestSchema()},
+	}
+	resp := &resource.UpdateResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionsTestSchema()},
+	}
+	req.Plan.Set(ctx, testPermissionsUnitModel(t, "read"))
+	req.State.Set(ctx, testPermissionsUnitModel(t, "read", "execute"))
+
+	r.Update(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockV2.AssertExpectations(t)
+}
+
+func TestPermissionsResource_Delete(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "!deny") && !contains(buf.String(), "!permit")
+	})).Return(&conjurapi.PolicyResponse{}, nil).Once()
+
+	r := &ConjurPermissionsResource{
+		client: mockV2,
+	}
+
+	ctx := context.Background()
+	req := resource.DeleteRequest{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionsTestSchema()},
+	}
+	resp := &resource.DeleteResponse{
+		State: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil), Schema: getPermissionsTestSchema()},
+	}
+	req.State.Set(ctx, testPermissionsUnitModel(t, "read", "execute"))
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockV2.AssertExpectations(t)
+}
+
+func getPermissionsTestSchema() schema.Schema {
+	r := &ConjurPermissionsResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
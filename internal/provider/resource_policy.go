@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	policyModePost    = "post"
+	policyModePatch   = "patch"
+	policyModeReplace = "replace"
+
+	// emptyPolicyDocument is loaded in replace mode to remove every record from a branch
+	emptyPolicyDocument = "[]\n"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurPolicyResource{}
+	_ resource.ResourceWithConfigure      = &ConjurPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurPolicyResource{}
+)
+
+func NewConjurPolicyResource() resource.Resource {
+	return &ConjurPolicyResource{}
+}
+
+// ConjurPolicyResource defines the resource implementation.
+type ConjurPolicyResource struct {
+	client api.ClientV2
+}
+
+// ConjurPolicyResourceModel describes the resource data model.
+type ConjurPolicyResourceModel struct {
+	Branch       types.String `tfsdk:"branch"`
+	Policy       types.String `tfsdk:"policy"`
+	Mode         types.String `tfsdk:"mode"`
+	CreatedRoles types.Map    `tfsdk:"created_roles"`
+	Version      types.Int64  `tfsdk:"version"`
+}
+
+func (r *ConjurPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (r *ConjurPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager policy resource. This resource loads a complete policy document into a policy branch. " +
+			"When `mode` is `replace`, destroying the resource empties the branch; in `post` and `patch` modes the loaded records are left in place on destroy.",
+
+		Attributes: map[string]schema.Attribute{
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch to load the document into (e.g. `root` or `data/apps`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "The policy document in YAML format",
+				Required:            true,
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The policy load mode: `post` (append only), `patch` (add and explicitly delete records) or `replace` (replace the branch contents). Defaults to `patch`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(policyModePatch),
+			},
+			"created_roles": schema.MapAttribute{
+				MarkdownDescription: "Map of role IDs created by policy loads of this resource to their API keys",
+				Computed:            true,
+				Sensitive:           true,
+				ElementType:         types.StringType,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: "The policy version created by the most recent load",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ConjurPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateBranch(data.Branch, &resp.Diagnostics, "branch")
+	ValidateNonEmpty(data.Policy, &resp.Diagnostics, "Policy")
+	ValidateContainedIn(data.Mode, &resp.Diagnostics, "mode", []string{policyModePost, policyModePatch, policyModeReplace}, true)
+
+	// Catch malformed YAML before it reaches the server
+	if !data.Policy.IsNull() && !data.Policy.IsUnknown() {
+		var node yaml.Node
+		if err := yaml.Unmarshal([]byte(data.Policy.ValueString()), &node); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid policy",
+				fmt.Sprintf("Policy is not valid YAML: %s", err),
+			)
+		}
+	}
+}
+
+func (r *ConjurPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurPolicyResourceModel
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// Destroy only loads policy in replace mode
+		if data.Mode.ValueString() == policyModeReplace {
+			dryRunPolicy(ctx, r.client, conjurapi.PolicyModePut, emptyPolicyDocument, data.Branch.ValueString(), &resp.Diagnostics)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := parsePolicyMode(data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Policy Mode", err.Error())
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, mode, data.Policy.ValueString(), data.Branch.ValueString(), &resp.Diagnostics)
+}
+
+func (r *ConjurPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := parsePolicyMode(data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Policy Mode", err.Error())
+		return
+	}
+
+	policyResp, err := policy.LoadPolicy(r.client, mode, data.Policy.ValueString(), data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Loading Policy", fmt.Sprintf("Could not load policy into branch %q: %s", data.Branch.ValueString(), err))
+		return
+	}
+
+	r.parsePolicyResponse(ctx, policyResp, types.MapNull(types.StringType), &data)
+
+	tflog.Trace(ctx, "created policy resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The loaded document can't be read back, so only validate that the branch still exists
+	branchID := fmt.Sprintf("policy:%s", strings.Trim(data.Branch.ValueString(), "/"))
+	exists, err := r.client.ResourceExists(branchID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Secrets Manager policy",
+			fmt.Sprintf("Unable to check if policy branch %q exists: %s", branchID, err),
+		)
+		return
+	}
+
+	if !exists {
+		resp.Diagnostics.AddWarning("Policy Branch Not Found", fmt.Sprintf("The policy branch %q was not found in Secrets Manager and the policy will be removed from the state.", branchID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "read policy resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state ConjurPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := parsePolicyMode(data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Policy Mode", err.Error())
+		return
+	}
+
+	policyResp, err := policy.LoadPolicy(r.client, mode, data.Policy.ValueString(), data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Loading Policy", fmt.Sprintf("Could not load policy into branch %q: %s", data.Branch.ValueString(), err))
+		return
+	}
+
+	r.parsePolicyResponse(ctx, policyResp, state.CreatedRoles, &data)
+
+	tflog.Trace(ctx, "updated policy resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurPolicyResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only replace mode owns the full contents of the branch, so it's the only mode that can clean up on destroy
+	if data.Mode.ValueString() != policyModeReplace {
+		resp.Diagnostics.AddWarning(
+			"Policy records not removed",
+			fmt.Sprintf("The policy was loaded into branch %q in %s mode, so its records are left in place. Use mode \"replace\" to empty the branch on destroy.", data.Branch.ValueString(), data.Mode.ValueString()),
+		)
+		return
+	}
+
+	_, err := policy.LoadPolicy(r.client, conjurapi.PolicyModePut, emptyPolicyDocument, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Loading Policy", fmt.Sprintf("Could not empty policy branch %q: %s", data.Branch.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted policy resource")
+}
+
+// parsePolicyResponse merges the roles created by a policy load into the previously created roles
+func (r *ConjurPolicyResource) parsePolicyResponse(ctx context.Context, policyResp *conjurapi.PolicyResponse, previous types.Map, data *ConjurPolicyResourceModel) {
+	createdRoles := map[string]string{}
+	if !previous.IsNull() && !previous.IsUnknown() {
+		previous.ElementsAs(ctx, &createdRoles, false)
+	}
+	for id, role := range policyResp.CreatedRoles {
+		createdRoles[id] = role.APIKey
+	}
+
+	data.CreatedRoles, _ = types.MapValueFrom(ctx, types.StringType, createdRoles)
+	data.Version = types.Int64Value(int64(policyResp.Version))
+}
+
+// parsePolicyMode maps the mode attribute to the corresponding API policy mode
+func parsePolicyMode(mode string) (conjurapi.PolicyMode, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case policyModePost:
+		return conjurapi.PolicyModePost, nil
+	case policyModePatch, "":
+		return conjurapi.PolicyModePatch, nil
+	case policyModeReplace:
+		return conjurapi.PolicyModePut, nil
+	default:
+		return 0, fmt.Errorf("unsupported policy mode %q, expected one of: post, patch, replace", mode)
+	}
+}
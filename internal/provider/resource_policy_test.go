@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurPolicyResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := NewConjurPolicyResource()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	ds.Schema(ctx, schemaRequest, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestParsePolicyMode(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected conjurapi.PolicyMode
+		wantErr  bool
+	}{
+		{input: "post", expected: conjurapi.PolicyModePost},
+		{input: "patch", expected: conjurapi.PolicyModePatch},
+		{input: "replace", expected: conjurapi.PolicyModePut},
+		{input: " Replace ", expected: conjurapi.PolicyModePut},
+		{input: "", expected: conjurapi.PolicyModePatch},
+		{input: "put", wantErr: true},
+		{input: "delete", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			mode, err := parsePolicyMode(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, mode)
+		})
+	}
+}
+
+func TestConjurPolicyResource_parsePolicyResponse(t *testing.T) {
+	r := &ConjurPolicyResource{}
+	ctx := context.Background()
+
+	t.Run("Roles from a single load", func(t *testing.T) {
+		data := &ConjurPolicyResourceModel{}
+		r.parsePolicyResponse(ctx, &conjurapi.PolicyResponse{
+			CreatedRoles: map[string]conjurapi.CreatedRole{
+				"conjur:host:data/app": {ID: "conjur:host:data/app", APIKey: "key-1"},
+			},
+			Version: 3,
+		}, types.MapNull(types.StringType), data)
+
+		var roles map[string]string
+		require.False(t, data.CreatedRoles.ElementsAs(ctx, &roles, false).HasError())
+		assert.Equal(t, map[string]string{"conjur:host:data/app": "key-1"}, roles)
+		assert.Equal(t, int64(3), data.Version.ValueInt64())
+	})
+
+	t.Run("Roles are merged with previous loads", func(t *testing.T) {
+		previous := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"conjur:host:data/app": types.StringValue("key-1"),
+		})
+		data := &ConjurPolicyResourceModel{}
+		r.parsePolicyResponse(ctx, &conjurapi.PolicyResponse{
+			CreatedRoles: map[string]conjurapi.CreatedRole{
+				"conjur:user:alice@data": {ID: "conjur:user:alice@data", APIKey: "key-2"},
+			},
+			Version: 4,
+		}, previous, data)
+
+		var roles map[string]string
+		require.False(t, data.CreatedRoles.ElementsAs(ctx, &roles, false).HasError())
+		assert.Len(t, roles, 2)
+		assert.Equal(t, "key-1", roles["conjur:host:data/app"])
+		assert.Equal(t, "key-2", roles["conjur:user:alice@data"])
+	})
+}
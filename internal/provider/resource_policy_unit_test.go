@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testPolicyDocument = `- !group developers
+- !host app
+`
+
+func TestPolicyResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          ConjurPolicyResourceModel
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+		expectedRoles int
+	}{
+		{
+			name: "successful load in patch mode",
+			data: ConjurPolicyResourceModel{
+				Branch: types.StringValue("data/test"),
+				Policy: types.StringValue(testPolicyDocument),
+				Mode:   types.StringValue("patch"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/test", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return buf.String() == testPolicyDocument
+				})).Return(&conjurapi.PolicyResponse{
+					CreatedRoles: map[string]conjurapi.CreatedRole{
+						"conjur:host:data/test/app": {ID: "conjur:host:data/test/app", APIKey: "api-key"},
+					},
+					Version: 1,
+				}, nil)
+			},
+			expectedError: false,
+			expectedRoles: 1,
+		},
+		{
+			name: "successful load in replace mode",
+			data: ConjurPolicyResourceModel{
+				Branch: types.StringValue("data/test"),
+				Policy: types.StringValue(testPolicyDocument),
+				Mode:   types.StringValue("replace"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePut, "data/test", mock.Anything).Return(&conjurapi.PolicyResponse{Version: 2}, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "successful load in post mode",
+			data: ConjurPolicyResourceModel{
+				Branch: types.StringValue("root"),
+				Policy: types.StringValue(testPolicyDocument),
+				Mode:   types.StringValue("post"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePost, "root", mock.Anything).Return(&conjurapi.PolicyResponse{Version: 5}, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "API error during load",
+			data: ConjurPolicyResourceModel{
+				Branch: types.StringValue("data/test"),
+				Policy: types.StringValue(testPolicyDocument),
+				Mode:   types.StringValue("patch"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/test", mock.Anything).Return(
+					nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Could not load policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurPolicyResource{
+				client: mockV2,
+			}
+
+			tt.data.CreatedRoles = types.MapUnknown(types.StringType)
+			tt.data.Version = types.Int64Unknown()
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPolicyTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPolicyTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.Plan.Set(ctx, &tt.data)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurPolicyResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Len(t, result.CreatedRoles.Elements(), tt.expectedRoles)
+				assert.False(t, result.Version.IsUnknown())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPolicyResource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          ConjurPolicyResourceModel
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		shouldRemove  bool
+		errorContains string
+	}{
+		{
+			name: "policy branch exists",
+			data: ConjurPolicyResourceModel{
+				Branch:       types.StringValue("data/test"),
+				Policy:       types.StringValue(testPolicyDocument),
+				Mode:         types.StringValue("patch"),
+				CreatedRoles: types.MapNull(types.StringType),
+				Version:      types.Int64Value(1),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourceExists", "policy:data/test").Return(true, nil)
+			},
+			expectedError: false,
+			shouldRemove:  false,
+		},
+		{
+			name: "policy branch removed - removes from state",
+			data: ConjurPolicyResourceModel{
+				Branch:       types.StringValue("data/missing"),
+				Policy:       types.StringValue(testPolicyDocument),
+				Mode:         types.StringValue("patch"),
+				CreatedRoles: types.MapNull(types.StringType),
+				Version:      types.Int64Value(1),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourceExists", "policy:data/missing").Return(false, nil)
+			},
+			expectedError: false,
+			shouldRemove:  true,
+		},
+		{
+			name: "API error checking branch",
+			data: ConjurPolicyResourceModel{
+				Branch:       types.StringValue("data/test"),
+				Policy:       types.StringValue(testPolicyDocument),
+				Mode:         types.StringValue("patch"),
+				CreatedRoles: types.MapNull(types.StringType),
+				Version:      types.Int64Value(1),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("ResourceExists", "policy:data/test").Return(false, fmt.Errorf("connection error"))
+			},
+			expectedError: true,
+			errorContains: "Unable to check if policy branch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurPolicyResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPolicyTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPolicyTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &tt.data)
+
+			r.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurPolicyResourceModel
+				diag := resp.State.Get(ctx, &result)
+				if tt.shouldRemove {
+					assert.True(t, diag.HasError() || result.Branch.IsNull())
+				} else {
+					assert.Equal(t, tt.data.Policy.ValueString(), result.Policy.ValueString())
+				}
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPolicyResource_Delete(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            ConjurPolicyResourceModel
+		setupMock       func(*mocks.MockClientV2)
+		expectedError   bool
+		expectedWarning bool
+		errorContains   string
+	}{
+		{
+			name: "replace mode empties the branch",
+			data: ConjurPolicyResourceModel{
+				Branch:       types.StringValue("data/test"),
+				Policy:       types.StringValue(testPolicyDocument),
+				Mode:         types.StringValue("replace"),
+				CreatedRoles: types.MapNull(types.StringType),
+				Version:      types.Int64Value(1),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePut, "data/test", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return buf.String() == emptyPolicyDocument
+				})).Return(&conjurapi.PolicyResponse{Version: 2}, nil)
+			},
+			expectedError: false,
+		},
+		{
+			name: "patch mode leaves records in place",
+			data: ConjurPolicyResourceModel{
+				Branch:       types.StringValue("data/test"),
+				Policy:       types.StringValue(testPolicyDocument),
+				Mode:         types.StringValue("patch"),
+				CreatedRoles: types.MapNull(types.StringType),
+				Version:      types.Int64Value(1),
+			},
+			setupMock:       func(mockV2 *mocks.MockClientV2) {},
+			expectedError:   false,
+			expectedWarning: true,
+		},
+		{
+			name: "API error emptying the branch",
+			data: ConjurPolicyResourceModel{
+				Branch:       types.StringValue("data/test"),
+				Policy:       types.StringValue(testPolicyDocument),
+				Mode:         types.StringValue("replace"),
+				CreatedRoles: types.MapNull(types.StringType),
+				Version:      types.Int64Value(1),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePut, "data/test", mock.Anything).Return(nil, fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Could not empty policy branch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurPolicyResource{
+				client: mockV2,
+			}
+
+			req := resource.DeleteRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPolicyTestSchema(),
+				},
+			}
+			resp := &resource.DeleteResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getPolicyTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &tt.data)
+
+			r.Delete(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectedWarning, resp.Diagnostics.WarningsCount() > 0)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getPolicyTestSchema() schema.Schema {
+	r := &ConjurPolicyResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -37,6 +37,7 @@ var (
 	_ resource.ResourceWithImportState    = &ConjurSecretResource{}
 	_ resource.ResourceWithConfigure      = &ConjurSecretResource{}
 	_ resource.ResourceWithValidateConfig = &ConjurSecretResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurSecretResource{}
 )
 
 func NewConjurSecretResource() resource.Resource {
@@ -209,6 +210,28 @@ func (r *ConjurSecretResource) ValidateConfig(ctx context.Context, req resource.
 	}
 }
 
+func (r *ConjurSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Secrets are created through the API rather than policy, so only deletion can be validated
+	if !req.Plan.Raw.IsNull() || !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deletionPolicy, err := r.generateSecretDeletionPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Building Secret Delete Policy", fmt.Sprintf("Could not build Secret Delete policy: %s", err))
+		return
+	}
+
+	branch := strings.TrimPrefix(data.Branch.ValueString(), "/")
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, deletionPolicy, branch, &resp.Diagnostics)
+}
+
 func (r *ConjurSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	if r.client == nil {
 		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurUserResource{}
+	_ resource.ResourceWithConfigure      = &ConjurUserResource{}
+	_ resource.ResourceWithImportState    = &ConjurUserResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurUserResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurUserResource{}
+)
+
+func NewConjurUserResource() resource.Resource {
+	return &ConjurUserResource{}
+}
+
+// ConjurUserResource defines the resource implementation.
+type ConjurUserResource struct {
+	client api.ClientV2
+}
+
+// ConjurUserResourceModel describes the resource data model.
+type ConjurUserResourceModel struct {
+	Name         types.String      `tfsdk:"name"`
+	Branch       types.String      `tfsdk:"branch"`
+	Owner        *ConjurOwnerModel `tfsdk:"owner"`
+	Annotations  map[string]string `tfsdk:"annotations"`
+	RestrictedTo types.List        `tfsdk:"restricted_to"`
+	FullID       types.String      `tfsdk:"full_id"`
+	APIKey       types.String      `tfsdk:"api_key"`
+}
+
+func (r *ConjurUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *ConjurUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager User resource. This resource creates a user in Conjur using policy. " +
+			"The user's API key is only returned when the user is first created.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Owner of the user",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "Owner kind (user, group, etc.)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Owner identifier",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Key-value annotations for the user",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"restricted_to": schema.ListAttribute{
+				MarkdownDescription: "List of CIDR ranges the user is allowed to authenticate from",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"full_id": schema.StringAttribute{
+				MarkdownDescription: "Computed identifier of the user role: `<name>@<branch>`, with `/` in the branch replaced by `-`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "The API key of the user, returned by Conjur when the user is created",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConjurUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurUserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.Name, &resp.Diagnostics, "User name")
+	ValidateBranch(data.Branch, &resp.Diagnostics, "branch")
+}
+
+func (r *ConjurUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurUserResourceModel
+	var userPolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		userPolicy, err = r.generateUserDeletionPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		userPolicy, err = r.generateUserPolicy(ctx, &data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate user policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, userPolicy, data.Branch.ValueString(), &resp.Diagnostics)
+}
+
+func (r *ConjurUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurUserResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userPolicy, err := r.generateUserPolicy(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate user policy: %s", err))
+		return
+	}
+
+	// Keep the policy response rather than going through ApplyPolicy so the API key of the new user can be captured
+	policyResp, err := policy.PatchPolicy(r.client, userPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply user policy: %s", err))
+		return
+	}
+
+	data.FullID = types.StringValue(conjurUserID(data.Branch.ValueString(), data.Name.ValueString()))
+	data.APIKey = types.StringNull()
+	if apiKey, ok := findCreatedRoleAPIKey(policyResp, "user", data.FullID.ValueString()); ok {
+		data.APIKey = types.StringValue(apiKey)
+	} else {
+		resp.Diagnostics.AddWarning(
+			"User API Key Not Returned",
+			fmt.Sprintf("Conjur did not return an API key for user %q, which usually means the user already existed. The api_key attribute will be empty.", data.FullID.ValueString()),
+		)
+	}
+
+	tflog.Trace(ctx, "created user resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fullID := conjurUserID(data.Branch.ValueString(), data.Name.ValueString())
+	userID := fmt.Sprintf("user:%s", fullID)
+	exists, err := r.client.RoleExists(userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Conjur user",
+			fmt.Sprintf("Unable to check if user %q exists: %s", userID, err),
+		)
+		return
+	}
+
+	// Remove the user if it has been removed from Conjur (or is inaccessible to the provider)
+	if !exists {
+		resp.Diagnostics.AddWarning("User Not Found", fmt.Sprintf("The user %q was not found in Conjur and will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the user exists and can be managed by the provider identity.", userID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	role, err := r.client.Role(userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Conjur user",
+			fmt.Sprintf("Unable to read user %q: %s", userID, err),
+		)
+		return
+	}
+
+	// Only refresh the network restrictions when the server reports them, owner and annotations
+	// aren't part of the role response so they're assumed unchanged
+	if restrictedTo, ok := role["restricted_to"].([]interface{}); ok {
+		cidrs := make([]attr.Value, 0, len(restrictedTo))
+		for _, cidr := range restrictedTo {
+			if s, ok := cidr.(string); ok {
+				cidrs = append(cidrs, types.StringValue(s))
+			}
+		}
+		if len(cidrs) > 0 || !data.RestrictedTo.IsNull() {
+			data.RestrictedTo = types.ListValueMust(types.StringType, cidrs)
+		}
+	}
+
+	data.FullID = types.StringValue(fullID)
+
+	tflog.Trace(ctx, "read user resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data, state ConjurUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only restricted_to can change in place, redeclaring the user in PATCH mode replaces its restrictions
+	userPolicy, err := r.generateUserPolicy(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate user policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, userPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply user policy: %s", err))
+		return
+	}
+
+	data.FullID = types.StringValue(conjurUserID(data.Branch.ValueString(), data.Name.ValueString()))
+	data.APIKey = state.APIKey
+
+	tflog.Trace(ctx, "updated user resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurUserResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userPolicy, err := r.generateUserDeletionPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Deletion Policy", fmt.Sprintf("Could not generate user deletion policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, userPolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Deletion Policy", fmt.Sprintf("Could not apply user deletion policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted user resource")
+}
+
+func (r *ConjurUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := strings.Trim(req.ID, "/")
+	if id == "" || !strings.Contains(id, "/") {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected format: <branch>/<name>, e.g. data/users/alice")
+		return
+	}
+
+	branch, name := splitParentAndName(id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("full_id"), conjurUserID(branch, name))...)
+}
+
+// generateUserPolicy creates a Conjur policy for creating a user
+func (r *ConjurUserResource) generateUserPolicy(ctx context.Context, data *ConjurUserResourceModel) (string, error) {
+	owner, err := policyOwnerRef(data.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	user := policyUser{
+		Id:          data.Name.ValueString(),
+		Owner:       owner,
+		Annotations: policyAnnotations(data.Annotations),
+	}
+
+	if !data.RestrictedTo.IsNull() && !data.RestrictedTo.IsUnknown() {
+		if diags := data.RestrictedTo.ElementsAs(ctx, &user.RestrictedTo, false); diags.HasError() {
+			return "", fmt.Errorf("invalid restricted_to: %v", diags)
+		}
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{user}
+
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// generateUserDeletionPolicy creates a policy to delete a user
+func (r *ConjurUserResource) generateUserDeletionPolicy(data *ConjurUserResourceModel) (string, error) {
+	delete := conjurpolicy.Delete{
+		Record: conjurpolicy.UserRef(data.Name.ValueString()),
+	}
+
+	policyStatements := conjurpolicy.PolicyStatements{delete}
+	yamlBytes, err := yaml.Marshal(policyStatements)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal deletion policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// conjurUserID returns the role ID Conjur assigns to a user declared in a branch. Users outside the
+// root branch are named `<name>@<branch>` with the branch's `/` separators replaced by `-`.
+func conjurUserID(branch, name string) string {
+	branch = strings.Trim(branch, "/")
+	if branch == "" || branch == "root" {
+		return name
+	}
+	return fmt.Sprintf("%s@%s", name, strings.ReplaceAll(branch, "/", "-"))
+}
+
+// findCreatedRoleAPIKey looks up the API key of a role created by a policy load. Created roles are
+// keyed by fully qualified ID (`<account>:<kind>:<id>`), so the account prefix is ignored.
+func findCreatedRoleAPIKey(policyResp *conjurapi.PolicyResponse, kind, id string) (string, bool) {
+	if policyResp == nil {
+		return "", false
+	}
+	suffix := fmt.Sprintf(":%s:%s", kind, id)
+	for roleID, role := range policyResp.CreatedRoles {
+		if strings.HasSuffix(roleID, suffix) && role.APIKey != "" {
+			return role.APIKey, true
+		}
+	}
+	return "", false
+}
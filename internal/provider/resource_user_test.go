@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConjurUserResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ds := NewConjurUserResource()
+
+	schemaRequest := resource.SchemaRequest{}
+	schemaResponse := &resource.SchemaResponse{}
+
+	ds.Schema(ctx, schemaRequest, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestConjurUserResource_generateUserPolicy(t *testing.T) {
+	r := &ConjurUserResource{}
+	ctx := context.Background()
+
+	t.Run("Minimum user fields provided", func(t *testing.T) {
+		data := &ConjurUserResourceModel{
+			Name:         types.StringValue("alice"),
+			Branch:       types.StringValue("data"),
+			RestrictedTo: types.ListNull(types.StringType),
+		}
+
+		userPolicy, err := r.generateUserPolicy(ctx, data)
+
+		require.NoError(t, err)
+		assert.Contains(t, userPolicy, "!user")
+		assert.Contains(t, userPolicy, "id: alice")
+		assert.NotContains(t, userPolicy, "owner:")
+		assert.NotContains(t, userPolicy, "restricted_to:")
+	})
+
+	t.Run("All user fields provided", func(t *testing.T) {
+		data := &ConjurUserResourceModel{
+			Name:   types.StringValue("alice"),
+			Branch: types.StringValue("data/users"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("group"),
+				ID:   types.StringValue("admins"),
+			},
+			Annotations: map[string]string{
+				"team": "security",
+			},
+			RestrictedTo: types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("10.0.0.0/16"),
+				types.StringValue("192.168.1.10"),
+			}),
+		}
+
+		userPolicy, err := r.generateUserPolicy(ctx, data)
+
+		require.NoError(t, err)
+		assert.Contains(t, userPolicy, "id: alice")
+		assert.Contains(t, userPolicy, "owner: !group admins")
+		assert.Contains(t, userPolicy, "restricted_to: [10.0.0.0/16, 192.168.1.10]")
+		assert.Contains(t, userPolicy, "team: security")
+	})
+}
+
+func TestConjurUserResource_generateUserDeletionPolicy(t *testing.T) {
+	r := &ConjurUserResource{}
+
+	deletionPolicy, err := r.generateUserDeletionPolicy(&ConjurUserResourceModel{
+		Name:   types.StringValue("alice"),
+		Branch: types.StringValue("data"),
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, deletionPolicy, "!delete")
+	assert.Contains(t, deletionPolicy, "record: !user alice")
+}
+
+// TestGenerateUserPolicy_YAMLInjection tests that user input cannot inject additional YAML statements
+func TestGenerateUserPolicy_YAMLInjection(t *testing.T) {
+	r := &ConjurUserResource{}
+	ctx := context.Background()
+
+	testCases := []struct {
+		name         string
+		userName     string
+		restrictedTo string
+		annotations  map[string]string
+	}{
+		{
+			name:     "newline injection in name",
+			userName: "alice\n- !delete\n  record: !variable injected",
+		},
+		{
+			name:         "newline injection in restricted_to",
+			userName:     "alice",
+			restrictedTo: "10.0.0.0/8]\n- !delete\n  record: !variable injected",
+		},
+		{
+			name:     "newline injection in annotation value",
+			userName: "alice",
+			annotations: map[string]string{
+				"key": "value\n- !delete\n  record: !variable injected",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := &ConjurUserResourceModel{
+				Name:         types.StringValue(tc.userName),
+				Branch:       types.StringValue("data"),
+				Annotations:  tc.annotations,
+				RestrictedTo: types.ListNull(types.StringType),
+			}
+			if tc.restrictedTo != "" {
+				data.RestrictedTo = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(tc.restrictedTo)})
+			}
+
+			policy, err := r.generateUserPolicy(ctx, data)
+			require.NoError(t, err)
+
+			var policyStatements conjurpolicy.PolicyStatements
+			err = yaml.Unmarshal([]byte(policy), &policyStatements)
+			require.NoError(t, err, "Policy should be valid YAML. Policy: %s", policy)
+			require.Len(t, policyStatements, 1, "Policy should contain exactly one statement. Policy: %s", policy)
+
+			userStmt, ok := policyStatements[0].(conjurpolicy.User)
+			require.True(t, ok, "First statement should be a User statement. Policy: %s", policy)
+			assert.Equal(t, tc.userName, userStmt.Id)
+		})
+	}
+}
+
+func TestConjurUserID(t *testing.T) {
+	tests := []struct {
+		branch   string
+		name     string
+		expected string
+	}{
+		{branch: "root", name: "admin", expected: "admin"},
+		{branch: "", name: "admin", expected: "admin"},
+		{branch: "data", name: "alice", expected: "alice@data"},
+		{branch: "/data/users/", name: "alice", expected: "alice@data-users"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, conjurUserID(tt.branch, tt.name))
+		})
+	}
+}
+
+func TestFindCreatedRoleAPIKey(t *testing.T) {
+	policyResp := &conjurapi.PolicyResponse{
+		CreatedRoles: map[string]conjurapi.CreatedRole{
+			"conjur:user:alice@data": {ID: "conjur:user:alice@data", APIKey: "alice-key"},
+			"conjur:host:data/alice": {ID: "conjur:host:data/alice", APIKey: "host-key"},
+		},
+	}
+
+	apiKey, ok := findCreatedRoleAPIKey(policyResp, "user", "alice@data")
+	assert.True(t, ok)
+	assert.Equal(t, "alice-key", apiKey)
+
+	_, ok = findCreatedRoleAPIKey(policyResp, "user", "bob@data")
+	assert.False(t, ok)
+
+	_, ok = findCreatedRoleAPIKey(nil, "user", "alice@data")
+	assert.False(t, ok)
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUserResource_Create(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            ConjurUserResourceModel
+		setupMock       func(*mocks.MockClientV2)
+		expectedError   bool
+		expectedWarning bool
+		expectedAPIKey  string
+		errorContains   string
+	}{
+		{
+			name: "successful user creation captures the API key",
+			data: ConjurUserResourceModel{
+				Name:   types.StringValue("alice"),
+				Branch: types.StringValue("data/users"),
+				RestrictedTo: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("10.0.0.0/16"),
+				}),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/users", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					content := buf.String()
+					return contains(content, "!user") && contains(content, "alice") && contains(content, "10.0.0.0/16")
+				})).Return(&conjurapi.PolicyResponse{
+					CreatedRoles: map[string]conjurapi.CreatedRole{
+						"conjur:user:alice@data-users": {ID: "conjur:user:alice@data-users", APIKey: "alice-api-key"},
+					},
+				}, nil)
+			},
+			expectedAPIKey: "alice-api-key",
+		},
+		{
+			name: "existing user returns no API key",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("bob"),
+				Branch:       types.StringValue("data"),
+				RestrictedTo: types.ListNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(&conjurapi.PolicyResponse{}, nil)
+			},
+			expectedWarning: true,
+		},
+		{
+			name: "API error during creation",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("alice"),
+				Branch:       types.StringValue("data"),
+				RestrictedTo: types.ListNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(
+					nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Could not apply user policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurUserResource{
+				client: mockV2,
+			}
+
+			tt.data.FullID = types.StringUnknown()
+			tt.data.APIKey = types.StringUnknown()
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getUserTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getUserTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.Plan.Set(ctx, &tt.data)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.expectedWarning, resp.Diagnostics.WarningsCount() > 0)
+
+				var result ConjurUserResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, conjurUserID(tt.data.Branch.ValueString(), tt.data.Name.ValueString()), result.FullID.ValueString())
+				assert.Equal(t, tt.expectedAPIKey, result.APIKey.ValueString())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserResource_Read(t *testing.T) {
+	tests := []struct {
+		name                 string
+		data                 ConjurUserResourceModel
+		setupMock            func(*mocks.MockClientV2)
+		expectedError        bool
+		shouldRemove         bool
+		expectedRestrictedTo []string
+		errorContains        string
+	}{
+		{
+			name: "user exists with network restrictions",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("alice"),
+				Branch:       types.StringValue("data/users"),
+				RestrictedTo: types.ListNull(types.StringType),
+				APIKey:       types.StringValue("alice-api-key"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleExists", "user:alice@data-users").Return(true, nil)
+				mockV2.On("Role", "user:alice@data-users").Return(map[string]interface{}{
+					"id":            "conjur:user:alice@data-users",
+					"restricted_to": []interface{}{"10.0.0.0/16"},
+				}, nil)
+			},
+			expectedRestrictedTo: []string{"10.0.0.0/16"},
+		},
+		{
+			name: "user removed - removes from state",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("alice"),
+				Branch:       types.StringValue("data"),
+				RestrictedTo: types.ListNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleExists", "user:alice@data").Return(false, nil)
+			},
+			shouldRemove: true,
+		},
+		{
+			name: "API error checking user",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("alice"),
+				Branch:       types.StringValue("data"),
+				RestrictedTo: types.ListNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("RoleExists", "user:alice@data").Return(false, fmt.Errorf("connection error"))
+			},
+			expectedError: true,
+			errorContains: "Unable to check if user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurUserResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getUserTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getUserTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &tt.data)
+
+			r.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+				var result ConjurUserResourceModel
+				diag := resp.State.Get(ctx, &result)
+				if tt.shouldRemove {
+					assert.True(t, diag.HasError() || result.Name.IsNull())
+				} else {
+					var restrictedTo []string
+					result.RestrictedTo.ElementsAs(ctx, &restrictedTo, false)
+					assert.Equal(t, tt.expectedRestrictedTo, restrictedTo)
+					assert.Equal(t, tt.data.APIKey.ValueString(), result.APIKey.ValueString())
+				}
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUserResource_Delete(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          ConjurUserResourceModel
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful deletion",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("alice"),
+				Branch:       types.StringValue("data/users"),
+				RestrictedTo: types.ListNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/users", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					content := buf.String()
+					return contains(content, "!delete") && contains(content, "!user alice")
+				})).Return(&conjurapi.PolicyResponse{}, nil)
+			},
+		},
+		{
+			name: "API error during deletion",
+			data: ConjurUserResourceModel{
+				Name:         types.StringValue("alice"),
+				Branch:       types.StringValue("data"),
+				RestrictedTo: types.ListNull(types.StringType),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data", mock.Anything).Return(
+					nil, fmt.Errorf("permission denied"))
+			},
+			expectedError: true,
+			errorContains: "Could not apply user deletion policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurUserResource{
+				client: mockV2,
+			}
+
+			req := resource.DeleteRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getUserTestSchema(),
+				},
+			}
+			resp := &resource.DeleteResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getUserTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &tt.data)
+
+			r.Delete(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				if tt.errorContains != "" {
+					found := false
+					for _, diag := range resp.Diagnostics.Errors() {
+						if contains(diag.Summary(), tt.errorContains) || contains(diag.Detail(), tt.errorContains) {
+							found = true
+							break
+						}
+					}
+					assert.True(t, found, "Expected error to contain: %s", tt.errorContains)
+				}
+			} else {
+				assert.False(t, resp.Diagnostics.HasError())
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getUserTestSchema() schema.Schema {
+	r := &ConjurUserResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
@@ -0,0 +1,461 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Conjur stores the kind and mime_type policy attributes of a variable as annotations
+	variableKindAnnotation     = "conjur/kind"
+	variableMimeTypeAnnotation = "conjur/mime_type"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurVariableResource{}
+	_ resource.ResourceWithConfigure      = &ConjurVariableResource{}
+	_ resource.ResourceWithImportState    = &ConjurVariableResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurVariableResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurVariableResource{}
+)
+
+func NewConjurVariableResource() resource.Resource {
+	return &ConjurVariableResource{}
+}
+
+// ConjurVariableResource defines the resource implementation.
+type ConjurVariableResource struct {
+	client api.ClientV2
+}
+
+// ConjurVariableResourceModel describes the resource data model.
+type ConjurVariableResourceModel struct {
+	Name           types.String      `tfsdk:"name"`
+	Branch         types.String      `tfsdk:"branch"`
+	Kind           types.String      `tfsdk:"kind"`
+	MimeType       types.String      `tfsdk:"mime_type"`
+	Owner          *ConjurOwnerModel `tfsdk:"owner"`
+	Annotations    map[string]string `tfsdk:"annotations"`
+	Value          types.String      `tfsdk:"value"`
+	ValueWO        types.String      `tfsdk:"value_wo"`
+	ValueWOVersion types.Int32       `tfsdk:"value_wo_version"`
+}
+
+func (r *ConjurVariableResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable"
+}
+
+func (r *ConjurVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager Variable resource. This resource declares a variable in Conjur using policy and sets its value through the secrets API, " +
+			"so unlike `conjur_secret` it works on Conjur Open Source and Enterprise.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the variable",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch of the variable",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "A descriptive kind for the variable (e.g. `password`)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mime_type": schema.StringAttribute{
+				MarkdownDescription: "The variable mime_type",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Owner of the variable",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "Owner kind (user, group, etc.)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Owner identifier",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Key-value annotations for the variable",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The variable value",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.PreferWriteOnlyAttribute(
+						path.MatchRoot("value_wo"),
+					),
+				},
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "The variable value",
+				Optional:            true,
+				WriteOnly:           true,
+			},
+			"value_wo_version": schema.Int32Attribute{
+				MarkdownDescription: "The variable value version. Used together with `value_wo` to trigger an update.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *ConjurVariableResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurVariableResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.Name, &resp.Diagnostics, "Variable name")
+	ValidateBranch(data.Branch, &resp.Diagnostics, "branch")
+
+	// Validate that value and value_wo are mutually exclusive
+	hasValue := !data.Value.IsNull() && !data.Value.IsUnknown()
+	hasValueWO := !data.ValueWO.IsNull() && !data.ValueWO.IsUnknown()
+	if hasValue && hasValueWO {
+		resp.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			"Cannot set both 'value' and 'value_wo' attributes. Use 'value_wo' (write-only) to avoid storing the variable value in Terraform state, or use 'value' (read-write) to manage the variable value in state.",
+		)
+	}
+
+	// Validate that value_wo_version requires value_wo
+	if !data.ValueWOVersion.IsNull() && !data.ValueWOVersion.IsUnknown() {
+		if data.ValueWO.IsNull() || data.ValueWO.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"Invalid Attribute Combination",
+				"The 'value_wo_version' attribute requires 'value_wo' to be set. 'value_wo_version' is used together with 'value_wo' to trigger an update.",
+			)
+		}
+	}
+}
+
+func (r *ConjurVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurVariableResourceModel
+	var variablePolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		variablePolicy, err = r.generateVariableDeletionPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		variablePolicy, err = r.generateVariablePolicy(&data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate variable policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, variablePolicy, data.Branch.ValueString(), &resp.Diagnostics)
+}
+
+func (r *ConjurVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurVariableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variablePolicy, err := r.generateVariablePolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate variable policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, variablePolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply variable policy: %s", err))
+		return
+	}
+
+	// Store the state before setting the value, so a failed value update doesn't orphan the variable
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// Record whether we're using value or value_wo so Read knows whether to fetch the value
+	if valueRW, ok := r.setValue(ctx, req.Config, &data, &resp.Diagnostics); ok && resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, valueRWKey, valueRW)...)
+	}
+
+	tflog.Trace(ctx, "created variable resource")
+}
+
+func (r *ConjurVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurVariableResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variableID := joinConjurID(data.Branch.ValueString(), data.Name.ValueString())
+	variable, err := r.client.Resource("variable:" + variableID)
+	if err != nil {
+		// Remove the variable if it has been removed from Conjur (or is inaccessible to the provider)
+		if isNotFoundErr(err) {
+			resp.Diagnostics.AddWarning("Variable Not Found", fmt.Sprintf("The variable %q was not found in Conjur and will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the variable exists and can be managed by the provider identity.", variableID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Conjur variable",
+			fmt.Sprintf("Unable to read variable %q: %s", variableID, err),
+		)
+		return
+	}
+
+	parseVariableResource(variable, &data)
+
+	// Determine if we should fetch the variable value:
+	// 1. If private state explicitly says "true" → fetch (using "value" attribute)
+	// 2. If private state is missing but value is already in state → fetch (likely imported/managed)
+	// 3. Otherwise → skip fetch (using "value_wo" or not managing value)
+	valueRW, diags := req.Private.GetKey(ctx, valueRWKey)
+	resp.Diagnostics.Append(diags...)
+	hasPrivateStateMarker := len(valueRW) > 0 && string(valueRW) == "true"
+	hasValueInState := !data.Value.IsNull() && !data.Value.IsUnknown()
+	shouldFetchValue := hasPrivateStateMarker || (len(valueRW) == 0 && hasValueInState)
+
+	if shouldFetchValue {
+		value, err := r.client.RetrieveSecret(variableID)
+		if err != nil {
+			resp.Diagnostics.AddWarning("Unable to fetch variable value", fmt.Sprintf("Could not fetch variable value for %q: %s", variableID, err))
+		} else {
+			data.Value = types.StringValue(string(value))
+			// Set private state marker if it was missing (e.g., after import)
+			if len(valueRW) == 0 && resp.Private != nil {
+				diags := resp.Private.SetKey(ctx, valueRWKey, []byte("true"))
+				resp.Diagnostics.Append(diags...)
+			}
+		}
+	}
+
+	tflog.Trace(ctx, "read variable resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Only the value can change in place, everything else is declared by policy and requires replacement
+func (r *ConjurVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurVariableResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Record whether we're using value or value_wo so Read knows whether to fetch the value
+	if valueRW, ok := r.setValue(ctx, req.Config, &data, &resp.Diagnostics); ok && resp.Private != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, valueRWKey, valueRW)...)
+	}
+
+	tflog.Trace(ctx, "updated variable resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurVariableResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variablePolicy, err := r.generateVariableDeletionPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Deletion Policy", fmt.Sprintf("Could not generate variable deletion policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, variablePolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Deletion Policy", fmt.Sprintf("Could not apply variable deletion policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted variable resource")
+}
+
+func (r *ConjurVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := strings.Trim(req.ID, "/")
+	if id == "" || !strings.Contains(id, "/") {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected format: <branch>/<name>, e.g. data/apps/db-password")
+		return
+	}
+
+	branch, name := splitParentAndName(id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// setValue adds the configured value or write-only value as a new version of the variable. It returns the
+// private state marker recording which of the two is in use, or false when no value is configured.
+func (r *ConjurVariableResource) setValue(ctx context.Context, config tfsdk.Config, data *ConjurVariableResourceModel, diags *diag.Diagnostics) ([]byte, bool) {
+	// Read value_wo from Config (write-only attributes are in Config, not Plan)
+	var valueWO types.String
+	diags.Append(config.GetAttribute(ctx, path.Root("value_wo"), &valueWO)...)
+
+	var value string
+	var valueRW []byte
+	switch {
+	case !valueWO.IsNull():
+		value, valueRW = valueWO.ValueString(), []byte{}
+	case !data.Value.IsNull():
+		value, valueRW = data.Value.ValueString(), []byte("true")
+	default:
+		return nil, false
+	}
+
+	variableID := joinConjurID(data.Branch.ValueString(), data.Name.ValueString())
+	if err := r.client.AddSecret(variableID, value); err != nil {
+		diags.AddError("Unable to set variable value", fmt.Sprintf("Could not set value for %q: %s", variableID, err))
+	}
+	return valueRW, true
+}
+
+// generateVariablePolicy creates a Conjur policy declaring the variable
+func (r *ConjurVariableResource) generateVariablePolicy(data *ConjurVariableResourceModel) (string, error) {
+	owner, err := policyOwnerRef(data.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	variable := policyVariable{
+		Id:          data.Name.ValueString(),
+		Kind:        data.Kind.ValueString(),
+		MimeType:    data.MimeType.ValueString(),
+		Owner:       owner,
+		Annotations: policyAnnotations(data.Annotations),
+	}
+
+	yamlBytes, err := yaml.Marshal(conjurpolicy.PolicyStatements{variable})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// generateVariableDeletionPolicy creates a policy to delete a variable
+func (r *ConjurVariableResource) generateVariableDeletionPolicy(data *ConjurVariableResourceModel) (string, error) {
+	delete := conjurpolicy.Delete{
+		Record: conjurpolicy.VariableRef(data.Name.ValueString()),
+	}
+
+	yamlBytes, err := yaml.Marshal(conjurpolicy.PolicyStatements{delete})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal deletion policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// parseVariableResource updates the model from a Conjur resource response. Owner and annotations are only
+// tracked when configured (or present), so records managed outside Terraform don't cause a diff.
+func parseVariableResource(variable map[string]interface{}, data *ConjurVariableResourceModel) {
+	annotations := recordAnnotations(variable)
+
+	data.Kind = types.StringNull()
+	if kind, ok := annotations[variableKindAnnotation]; ok {
+		data.Kind = types.StringValue(kind)
+		delete(annotations, variableKindAnnotation)
+	}
+	data.MimeType = types.StringNull()
+	if mimeType, ok := annotations[variableMimeTypeAnnotation]; ok {
+		data.MimeType = types.StringValue(mimeType)
+		delete(annotations, variableMimeTypeAnnotation)
+	}
+
+	if len(annotations) > 0 || data.Annotations != nil {
+		data.Annotations = annotations
+	}
+	refreshRecordOwner(variable, data.Branch.ValueString(), data.Owner)
+}
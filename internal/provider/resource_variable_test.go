@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurVariableResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewConjurVariableResource().Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestConjurVariableResource_generateVariablePolicy(t *testing.T) {
+	r := &ConjurVariableResource{}
+
+	t.Run("Minimum variable fields provided", func(t *testing.T) {
+		variablePolicy, err := r.generateVariablePolicy(&ConjurVariableResourceModel{
+			Name:   types.StringValue("db-password"),
+			Branch: types.StringValue("data/apps"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "- !variable\n  id: db-password\n", variablePolicy)
+	})
+
+	t.Run("All variable fields provided", func(t *testing.T) {
+		variablePolicy, err := r.generateVariablePolicy(&ConjurVariableResourceModel{
+			Name:     types.StringValue("tls-key"),
+			Branch:   types.StringValue("data/apps"),
+			Kind:     types.StringValue("private-key"),
+			MimeType: types.StringValue("application/x-pem-file"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("group"),
+				ID:   types.StringValue("admins"),
+			},
+			Annotations: map[string]string{"team": "payments"},
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, variablePolicy, "- !variable")
+		assert.Contains(t, variablePolicy, "kind: private-key")
+		assert.Contains(t, variablePolicy, "mime_type: application/x-pem-file")
+		assert.Contains(t, variablePolicy, "owner: !group admins")
+		assert.Contains(t, variablePolicy, "team: payments")
+	})
+
+	t.Run("Invalid owner kind", func(t *testing.T) {
+		_, err := r.generateVariablePolicy(&ConjurVariableResourceModel{
+			Name:   types.StringValue("db-password"),
+			Branch: types.StringValue("data/apps"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("bogus"),
+				ID:   types.StringValue("admins"),
+			},
+		})
+
+		assert.ErrorContains(t, err, "invalid owner kind")
+	})
+}
+
+func TestConjurVariableResource_generateVariableDeletionPolicy(t *testing.T) {
+	r := &ConjurVariableResource{}
+
+	variablePolicy, err := r.generateVariableDeletionPolicy(&ConjurVariableResourceModel{
+		Name:   types.StringValue("db-password"),
+		Branch: types.StringValue("data/apps"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "- !delete\n  record: !variable db-password\n", variablePolicy)
+}
+
+func TestParseVariableResource(t *testing.T) {
+	variable := map[string]interface{}{
+		"id":    "myaccount:variable:data/apps/tls-key",
+		"owner": "myaccount:group:data/apps/admins",
+		"annotations": []interface{}{
+			map[string]interface{}{"name": "conjur/kind", "value": "private-key"},
+			map[string]interface{}{"name": "conjur/mime_type", "value": "application/x-pem-file"},
+			map[string]interface{}{"name": "team", "value": "payments"},
+		},
+	}
+
+	t.Run("Owner configured relative to the branch", func(t *testing.T) {
+		data := &ConjurVariableResourceModel{
+			Branch: types.StringValue("data/apps"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("group"),
+				ID:   types.StringValue("admins"),
+			},
+		}
+
+		parseVariableResource(variable, data)
+
+		assert.Equal(t, "private-key", data.Kind.ValueString())
+		assert.Equal(t, "application/x-pem-file", data.MimeType.ValueString())
+		assert.Equal(t, map[string]string{"team": "payments"}, data.Annotations)
+		assert.Equal(t, "admins", data.Owner.ID.ValueString())
+	})
+
+	t.Run("Owner changed outside Terraform", func(t *testing.T) {
+		data := &ConjurVariableResourceModel{
+			Branch: types.StringValue("data/apps"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("user"),
+				ID:   types.StringValue("alice"),
+			},
+		}
+
+		parseVariableResource(variable, data)
+
+		assert.Equal(t, "group", data.Owner.Kind.ValueString())
+		assert.Equal(t, "data/apps/admins", data.Owner.ID.ValueString())
+	})
+
+	t.Run("Unconfigured owner and no annotations", func(t *testing.T) {
+		data := &ConjurVariableResourceModel{
+			Branch:   types.StringValue("data/apps"),
+			MimeType: types.StringValue("text/plain"),
+		}
+
+		parseVariableResource(map[string]interface{}{"owner": "myaccount:policy:data/apps"}, data)
+
+		assert.Nil(t, data.Owner)
+		assert.Nil(t, data.Annotations)
+		assert.True(t, data.MimeType.IsNull())
+	})
+}
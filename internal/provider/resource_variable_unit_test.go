@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestVariableResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          ConjurVariableResourceModel
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "variable declared and value set",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+				Value:  types.StringValue("secret"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return contains(buf.String(), "!variable") && contains(buf.String(), "id: db-password")
+				})).Return(&conjurapi.PolicyResponse{}, nil)
+				mockV2.On("AddSecret", "data/apps/db-password", "secret").Return(nil)
+			},
+		},
+		{
+			name: "write-only value",
+			data: ConjurVariableResourceModel{
+				Name:           types.StringValue("db-password"),
+				Branch:         types.StringValue("data/apps"),
+				ValueWO:        types.StringValue("write-only"),
+				ValueWOVersion: types.Int32Value(1),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.Anything).Return(&conjurapi.PolicyResponse{}, nil)
+				mockV2.On("AddSecret", "data/apps/db-password", "write-only").Return(nil)
+			},
+		},
+		{
+			name: "variable declared without a value",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.Anything).Return(&conjurapi.PolicyResponse{}, nil)
+			},
+		},
+		{
+			name: "policy error",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+				Value:  types.StringValue("secret"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.Anything).Return(nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Could not apply variable policy",
+		},
+		{
+			name: "value error",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+				Value:  types.StringValue("secret"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.Anything).Return(&conjurapi.PolicyResponse{}, nil)
+				mockV2.On("AddSecret", "data/apps/db-password", "secret").Return(fmt.Errorf("403 Forbidden"))
+			},
+			expectedError: true,
+			errorContains: "Could not set value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurVariableResource{
+				client: mockV2,
+			}
+
+			ctx := context.Background()
+			testSchema := getVariableTestSchema()
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+				Config: tfsdk.Config{
+					Raw:    testConfigValue(t, ctx, tfsdk.State{Schema: testSchema}, &tt.data),
+					Schema: testSchema,
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: testSchema,
+				},
+			}
+
+			planData := tt.data
+			planData.ValueWO = types.StringNull()
+			req.Plan.Set(ctx, &planData)
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVariableResource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          ConjurVariableResourceModel
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+		expectRemoved bool
+		expectedValue string
+	}{
+		{
+			name: "variable exists and value is in state",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+				Value:  types.StringValue("old"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/apps/db-password").Return(map[string]interface{}{
+					"id": "myaccount:variable:data/apps/db-password",
+				}, nil)
+				mockV2.On("RetrieveSecret", "data/apps/db-password").Return([]byte("rotated"), nil)
+			},
+			expectedValue: "rotated",
+		},
+		{
+			name: "variable exists and value is not managed",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/apps/db-password").Return(map[string]interface{}{
+					"id": "myaccount:variable:data/apps/db-password",
+				}, nil)
+			},
+		},
+		{
+			name: "variable not found",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/apps/db-password").Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectRemoved: true,
+		},
+		{
+			name: "API error",
+			data: ConjurVariableResourceModel{
+				Name:   types.StringValue("db-password"),
+				Branch: types.StringValue("data/apps"),
+			},
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "variable:data/apps/db-password").Return(nil, fmt.Errorf("connection error"))
+			},
+			expectedError: true,
+			errorContains: "Unable to read variable",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurVariableResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getVariableTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getVariableTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &tt.data)
+
+			r.Read(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else if tt.expectRemoved {
+				assert.False(t, resp.Diagnostics.HasError())
+				assert.True(t, resp.State.Raw.IsNull())
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+				var result ConjurVariableResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, tt.expectedValue, result.Value.ValueString())
+			}
+
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestVariableResource_Delete(t *testing.T) {
+	mockV2 := mocks.NewMockClientV2(t)
+	mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "data/apps", mock.MatchedBy(func(policy io.Reader) bool {
+		buf := new(strings.Builder)
+		_, _ = io.Copy(buf, policy)
+		return contains(buf.String(), "!delete") && contains(buf.String(), "!variable db-password")
+	})).Return(&conjurapi.PolicyResponse{}, nil)
+
+	r := &ConjurVariableResource{
+		client: mockV2,
+	}
+
+	req := resource.DeleteRequest{
+		State: tfsdk.State{
+			Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+			Schema: getVariableTestSchema(),
+		},
+	}
+	resp := &resource.DeleteResponse{}
+
+	ctx := context.Background()
+	req.State.Set(ctx, &ConjurVariableResourceModel{
+		Name:   types.StringValue("db-password"),
+		Branch: types.StringValue("data/apps"),
+	})
+
+	r.Delete(ctx, req, resp)
+
+	assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+	mockV2.AssertExpectations(t)
+}
+
+func getVariableTestSchema() schema.Schema {
+	r := &ConjurVariableResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
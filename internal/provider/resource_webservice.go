@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/cyberark/terraform-provider-conjur/internal/policy"
+	"github.com/doodlesbykumbi/conjur-policy-go/pkg/conjurpolicy"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                   = &ConjurWebserviceResource{}
+	_ resource.ResourceWithConfigure      = &ConjurWebserviceResource{}
+	_ resource.ResourceWithImportState    = &ConjurWebserviceResource{}
+	_ resource.ResourceWithValidateConfig = &ConjurWebserviceResource{}
+	_ resource.ResourceWithModifyPlan     = &ConjurWebserviceResource{}
+)
+
+func NewConjurWebserviceResource() resource.Resource {
+	return &ConjurWebserviceResource{}
+}
+
+// ConjurWebserviceResource defines the resource implementation.
+type ConjurWebserviceResource struct {
+	client api.ClientV2
+}
+
+// ConjurWebserviceResourceModel describes the resource data model.
+type ConjurWebserviceResourceModel struct {
+	Name        types.String      `tfsdk:"name"`
+	Branch      types.String      `tfsdk:"branch"`
+	Owner       *ConjurOwnerModel `tfsdk:"owner"`
+	Annotations map[string]string `tfsdk:"annotations"`
+}
+
+func (r *ConjurWebserviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webservice"
+}
+
+func (r *ConjurWebserviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "CyberArk Secrets Manager Webservice resource. This resource creates a webservice in Conjur using policy. " +
+			"Webservices authorize the use of authenticators and custom services, use `conjur_permission` to grant `authenticate` on them.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the webservice",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				MarkdownDescription: "The policy branch of the webservice (e.g. `conjur/authn-jwt/my-service`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.SingleNestedAttribute{
+				MarkdownDescription: "Owner of the webservice",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"kind": schema.StringAttribute{
+						MarkdownDescription: "Owner kind (user, group, etc.)",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"id": schema.StringAttribute{
+						MarkdownDescription: "Owner identifier",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"annotations": schema.MapAttribute{
+				MarkdownDescription: "Key-value annotations for the webservice",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConjurWebserviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ConjurWebserviceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ValidateNonEmpty(data.Name, &resp.Diagnostics, "Webservice name")
+	ValidateBranch(data.Branch, &resp.Diagnostics, "branch")
+}
+
+func (r *ConjurWebserviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(api.ClientV2)
+	if !ok {
+		AddUnexpectedConfigureTypeError(&resp.Diagnostics, "api.ClientV2", req.ProviderData)
+		return
+	}
+	r.client = client
+}
+
+func (r *ConjurWebserviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !shouldDryRunPolicy(r.client, req) {
+		return
+	}
+
+	var data ConjurWebserviceResourceModel
+	var webservicePolicy string
+	var err error
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		webservicePolicy, err = r.generateWebserviceDeletionPolicy(&data)
+	} else {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		webservicePolicy, err = r.generateWebservicePolicy(&data)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate webservice policy: %s", err))
+		return
+	}
+
+	dryRunPolicy(ctx, r.client, conjurapi.PolicyModePatch, webservicePolicy, data.Branch.ValueString(), &resp.Diagnostics)
+}
+
+func (r *ConjurWebserviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurWebserviceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webservicePolicy, err := r.generateWebservicePolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Policy", fmt.Sprintf("Could not generate webservice policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, webservicePolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Policy", fmt.Sprintf("Could not apply webservice policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created webservice resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurWebserviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurWebserviceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webserviceID := joinConjurID(data.Branch.ValueString(), data.Name.ValueString())
+	webservice, err := r.client.Resource("webservice:" + webserviceID)
+	if err != nil {
+		// Remove the webservice if it has been removed from Conjur (or is inaccessible to the provider)
+		if isNotFoundErr(err) {
+			resp.Diagnostics.AddWarning("Webservice Not Found", fmt.Sprintf("The webservice %q was not found in Conjur and will be removed from the state. If you did not expect this, please check your Conjur instance to ensure the webservice exists and can be managed by the provider identity.", webserviceID))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading Conjur webservice",
+			fmt.Sprintf("Unable to read webservice %q: %s", webserviceID, err),
+		)
+		return
+	}
+
+	if annotations := recordAnnotations(webservice); len(annotations) > 0 || data.Annotations != nil {
+		data.Annotations = annotations
+	}
+	refreshRecordOwner(webservice, data.Branch.ValueString(), data.Owner)
+
+	tflog.Trace(ctx, "read webservice resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConjurWebserviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurWebserviceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All attributes are marked RequiresReplace, so this should never be called
+	resp.Diagnostics.AddError("Update Not Supported", "This resource does not support in-place updates. Please recreate the resource to apply changes.")
+}
+
+func (r *ConjurWebserviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		AddProviderClientNotConfiguredWarning(&resp.Diagnostics)
+		return
+	}
+	var data ConjurWebserviceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webservicePolicy, err := r.generateWebserviceDeletionPolicy(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Generating Deletion Policy", fmt.Sprintf("Could not generate webservice deletion policy: %s", err))
+		return
+	}
+
+	err = policy.ApplyPolicy(r.client, webservicePolicy, data.Branch.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Applying Deletion Policy", fmt.Sprintf("Could not apply webservice deletion policy: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted webservice resource")
+}
+
+func (r *ConjurWebserviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := strings.Trim(req.ID, "/")
+	if id == "" || !strings.Contains(id, "/") {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected format: <branch>/<name>, e.g. conjur/authn-jwt/my-service/status")
+		return
+	}
+
+	branch, name := splitParentAndName(id)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
+
+// generateWebservicePolicy creates a Conjur policy declaring the webservice
+func (r *ConjurWebserviceResource) generateWebservicePolicy(data *ConjurWebserviceResourceModel) (string, error) {
+	owner, err := policyOwnerRef(data.Owner)
+	if err != nil {
+		return "", err
+	}
+
+	webservice := policyWebservice{
+		Id:          data.Name.ValueString(),
+		Owner:       owner,
+		Annotations: policyAnnotations(data.Annotations),
+	}
+
+	yamlBytes, err := yaml.Marshal(conjurpolicy.PolicyStatements{webservice})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
+
+// generateWebserviceDeletionPolicy creates a policy to delete a webservice
+func (r *ConjurWebserviceResource) generateWebserviceDeletionPolicy(data *ConjurWebserviceResourceModel) (string, error) {
+	delete := policyDelete{
+		Record: policyRef{Kind: "webservice", Id: data.Name.ValueString()},
+	}
+
+	yamlBytes, err := yaml.Marshal(conjurpolicy.PolicyStatements{delete})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal deletion policy to YAML: %w", err)
+	}
+
+	return string(yamlBytes), nil
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConjurWebserviceResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResponse := &resource.SchemaResponse{}
+
+	NewConjurWebserviceResource().Schema(ctx, resource.SchemaRequest{}, schemaResponse)
+	if schemaResponse.Diagnostics.HasError() {
+		t.Fatalf("Schema diagnostics had errors: %+v", schemaResponse.Diagnostics)
+	}
+
+	if diagnostics := schemaResponse.Schema.ValidateImplementation(ctx); diagnostics.HasError() {
+		t.Fatalf("Schema validation failed: %+v", diagnostics)
+	}
+}
+
+func TestConjurWebserviceResource_generateWebservicePolicy(t *testing.T) {
+	r := &ConjurWebserviceResource{}
+
+	t.Run("Minimum webservice fields provided", func(t *testing.T) {
+		webservicePolicy, err := r.generateWebservicePolicy(&ConjurWebserviceResourceModel{
+			Name:   types.StringValue("status"),
+			Branch: types.StringValue("conjur/authn-jwt/my-service"),
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "- !webservice\n  id: status\n", webservicePolicy)
+	})
+
+	t.Run("All webservice fields provided", func(t *testing.T) {
+		webservicePolicy, err := r.generateWebservicePolicy(&ConjurWebserviceResourceModel{
+			Name:   types.StringValue("my-service"),
+			Branch: types.StringValue("conjur/authn-jwt"),
+			Owner: &ConjurOwnerModel{
+				Kind: types.StringValue("group"),
+				ID:   types.StringValue("admins"),
+			},
+			Annotations: map[string]string{"description": "JWT authenticator"},
+		})
+
+		require.NoError(t, err)
+		assert.Contains(t, webservicePolicy, "- !webservice")
+		assert.Contains(t, webservicePolicy, "id: my-service")
+		assert.Contains(t, webservicePolicy, "owner: !group admins")
+		assert.Contains(t, webservicePolicy, "description: JWT authenticator")
+	})
+}
+
+func TestConjurWebserviceResource_generateWebserviceDeletionPolicy(t *testing.T) {
+	r := &ConjurWebserviceResource{}
+
+	webservicePolicy, err := r.generateWebserviceDeletionPolicy(&ConjurWebserviceResourceModel{
+		Name:   types.StringValue("my-service"),
+		Branch: types.StringValue("conjur/authn-jwt"),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "- !delete\n  record: !webservice my-service\n", webservicePolicy)
+}
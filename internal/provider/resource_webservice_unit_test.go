@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api/mocks"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebserviceResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		errorContains string
+	}{
+		{
+			name: "successful webservice creation",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "conjur/authn-jwt", mock.MatchedBy(func(policy io.Reader) bool {
+					buf := new(strings.Builder)
+					_, _ = io.Copy(buf, policy)
+					return contains(buf.String(), "!webservice") && contains(buf.String(), "id: my-service")
+				})).Return(&conjurapi.PolicyResponse{}, nil)
+			},
+		},
+		{
+			name: "API error during creation",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("LoadPolicy", conjurapi.PolicyModePatch, "conjur/authn-jwt", mock.Anything).Return(
+					nil, fmt.Errorf("422 Unprocessable Entity"))
+			},
+			expectedError: true,
+			errorContains: "Could not apply webservice policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurWebserviceResource{
+				client: mockV2,
+			}
+
+			req := resource.CreateRequest{
+				Plan: tfsdk.Plan{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getWebserviceTestSchema(),
+				},
+			}
+			resp := &resource.CreateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getWebserviceTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.Plan.Set(ctx, &ConjurWebserviceResourceModel{
+				Name:   types.StringValue("my-service"),
+				Branch: types.StringValue("conjur/authn-jwt"),
+			})
+
+			r.Create(ctx, req, resp)
+
+			if tt.expectedError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.True(t, diagnosticsContain(resp.Diagnostics, tt.errorContains), "Expected error to contain: %s", tt.errorContains)
+			} else {
+				assert.False(t, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func TestWebserviceResource_Read(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(*mocks.MockClientV2)
+		expectedError bool
+		expectRemoved bool
+	}{
+		{
+			name: "webservice exists",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "webservice:conjur/authn-jwt/my-service").Return(map[string]interface{}{
+					"id":    "myaccount:webservice:conjur/authn-jwt/my-service",
+					"owner": "myaccount:policy:conjur/authn-jwt",
+				}, nil)
+			},
+		},
+		{
+			name: "webservice not found",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "webservice:conjur/authn-jwt/my-service").Return(nil, fmt.Errorf("404 Not Found"))
+			},
+			expectRemoved: true,
+		},
+		{
+			name: "API error",
+			setupMock: func(mockV2 *mocks.MockClientV2) {
+				mockV2.On("Resource", "webservice:conjur/authn-jwt/my-service").Return(nil, fmt.Errorf("connection error"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockV2 := mocks.NewMockClientV2(t)
+			tt.setupMock(mockV2)
+
+			r := &ConjurWebserviceResource{
+				client: mockV2,
+			}
+
+			req := resource.ReadRequest{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getWebserviceTestSchema(),
+				},
+			}
+			resp := &resource.ReadResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(tftypes.Object{}, nil),
+					Schema: getWebserviceTestSchema(),
+				},
+			}
+
+			ctx := context.Background()
+			req.State.Set(ctx, &ConjurWebserviceResourceModel{
+				Name:   types.StringValue("my-service"),
+				Branch: types.StringValue("conjur/authn-jwt"),
+			})
+
+			r.Read(ctx, req, resp)
+
+			assert.Equal(t, tt.expectedError, resp.Diagnostics.HasError(), "%+v", resp.Diagnostics)
+			if tt.expectRemoved {
+				assert.True(t, resp.State.Raw.IsNull())
+			} else if !tt.expectedError {
+				var result ConjurWebserviceResourceModel
+				resp.State.Get(ctx, &result)
+				assert.Equal(t, "my-service", result.Name.ValueString())
+				assert.Nil(t, result.Owner)
+				assert.Nil(t, result.Annotations)
+			}
+			mockV2.AssertExpectations(t)
+		})
+	}
+}
+
+func getWebserviceTestSchema() schema.Schema {
+	r := &ConjurWebserviceResource{}
+	var schemaResp resource.SchemaResponse
+	r.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return schemaResp.Schema
+}
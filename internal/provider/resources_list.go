@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+)
+
+// resourcesPageSize is the number of resources requested per page when listing resources
+const resourcesPageSize = 100
+
+// listResources returns the resources matching a filter, starting at the filter offset. The matching resources are
+// counted first and then requested one page at a time, so results larger than the server page size are complete.
+// A limit of zero returns every matching resource.
+func listResources(client api.ClientV2, filter conjurapi.ResourceFilter, limit int) ([]map[string]interface{}, error) {
+	countFilter := filter
+	countFilter.Limit, countFilter.Offset = 0, 0
+	count, err := client.ResourcesCount(&countFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	total := count.Count - filter.Offset
+	if limit > 0 && limit < total {
+		total = limit
+	}
+	if total <= 0 {
+		return []map[string]interface{}{}, nil
+	}
+
+	resources := make([]map[string]interface{}, 0, total)
+	for len(resources) < total {
+		page := filter
+		page.Offset = filter.Offset + len(resources)
+		page.Limit = min(resourcesPageSize, total-len(resources))
+		results, err := client.Resources(&page)
+		if err != nil {
+			return nil, err
+		}
+		// Resources deleted since they were counted shorten the last page
+		if len(results) == 0 {
+			break
+		}
+		resources = append(resources, results...)
+	}
+	return resources, nil
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-conjur/internal/conjur/api"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// secretsRequestModel describes the batch retrieval attributes shared by the
+// conjur_secrets data source and ephemeral resource.
+type secretsRequestModel struct {
+	Names         types.List `tfsdk:"names"`
+	Variables     types.Map  `tfsdk:"variables"`
+	Safe          types.Bool `tfsdk:"safe"`
+	IgnoreMissing types.Bool `tfsdk:"ignore_missing"`
+}
+
+// names returns the configured secret names
+func (m secretsRequestModel) names() []types.String {
+	var names []types.String
+	for _, v := range m.Names.Elements() {
+		if s, ok := v.(types.String); ok {
+			names = append(names, s)
+		}
+	}
+	return names
+}
+
+// variables returns the configured alias to secret name mapping
+func (m secretsRequestModel) variables() map[string]types.String {
+	variables := map[string]types.String{}
+	for alias, v := range m.Variables.Elements() {
+		if s, ok := v.(types.String); ok {
+			variables[alias] = s
+		}
+	}
+	return variables
+}
+
+// validate checks that exactly one of names and variables is configured
+func (m secretsRequestModel) validate(diags *diag.Diagnostics) {
+	if m.Names.IsUnknown() || m.Variables.IsUnknown() {
+		return
+	}
+	if m.Names.IsNull() && m.Variables.IsNull() {
+		diags.AddError(
+			"Missing Attribute Configuration",
+			"Exactly one of 'names' or 'variables' must be set.",
+		)
+		return
+	}
+	if !m.Names.IsNull() && !m.Variables.IsNull() {
+		diags.AddError(
+			"Invalid Attribute Combination",
+			"Cannot set both 'names' and 'variables'. Use 'names' to key values by variable ID, or 'variables' to key them by your own aliases.",
+		)
+	}
+	for _, name := range m.names() {
+		ValidateNonEmpty(name, diags, "Secret name")
+	}
+	for alias, name := range m.variables() {
+		ValidateNonEmpty(name, diags, fmt.Sprintf("Secret name for %q", alias))
+	}
+}
+
+// variableIDs returns the sorted, de-duplicated variable IDs to retrieve
+func (m secretsRequestModel) variableIDs() []string {
+	seen := map[string]bool{}
+	var ids []string
+	add := func(v types.String) {
+		id := v.ValueString()
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, v := range m.names() {
+		add(v)
+	}
+	for _, v := range m.variables() {
+		add(v)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// values maps the retrieved secrets back to the keys used in the configuration:
+// variable IDs for names, aliases for variables. Missing variables are omitted.
+// Safe values are base64 encoded, since binary secrets can't be held in a Terraform string.
+func (m secretsRequestModel) values(secrets map[string][]byte) map[string]types.String {
+	value := func(v []byte) types.String {
+		if m.Safe.ValueBool() {
+			return types.StringValue(base64.StdEncoding.EncodeToString(v))
+		}
+		return types.StringValue(string(v))
+	}
+
+	values := map[string]types.String{}
+	if !m.Variables.IsNull() {
+		for alias, id := range m.variables() {
+			if v, ok := secrets[id.ValueString()]; ok {
+				values[alias] = value(v)
+			}
+		}
+		return values
+	}
+	for _, id := range m.names() {
+		if v, ok := secrets[id.ValueString()]; ok {
+			values[id.ValueString()] = value(v)
+		}
+	}
+	return values
+}
+
+// retrieveSecrets fetches all variables in a single batch call, keyed by variable ID.
+// When ignoreMissing is set and the batch fails, variables that do not exist are
+// dropped and the batch is retried with the remaining IDs. The dropped IDs are returned.
+func retrieveSecrets(client api.ClientV2, ids []string, safe, ignoreMissing bool) (map[string][]byte, []string, error) {
+	secrets, err := retrieveBatchSecrets(client, ids, safe)
+	if err == nil || !ignoreMissing {
+		return secrets, nil, err
+	}
+
+	var existing, missing []string
+	for _, id := range ids {
+		exists, existsErr := client.ResourceExists(secretVariableID(client, id))
+		if existsErr != nil {
+			return nil, nil, fmt.Errorf("%w (checking %q: %s)", err, id, existsErr)
+		}
+		if exists {
+			existing = append(existing, id)
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) == 0 {
+		return nil, nil, err
+	}
+	if len(existing) == 0 {
+		return map[string][]byte{}, missing, nil
+	}
+
+	secrets, err = retrieveBatchSecrets(client, existing, safe)
+	return secrets, missing, err
+}
+
+func retrieveBatchSecrets(client api.ClientV2, ids []string, safe bool) (map[string][]byte, error) {
+	var (
+		response map[string][]byte
+		err      error
+	)
+	if safe {
+		response, err = client.RetrieveBatchSecretsSafe(ids)
+	} else {
+		response, err = client.RetrieveBatchSecrets(ids)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The batch API keys values by fully qualified ID (account:variable:id)
+	secrets := make(map[string][]byte, len(response))
+	for fullID, value := range response {
+		secrets[shortVariableID(fullID)] = value
+	}
+	return secrets, nil
+}
+
+func secretVariableID(client api.ClientV2, id string) string {
+	return fmt.Sprintf("%s:variable:%s", client.GetConfig().Account, id)
+}
+
+func shortVariableID(fullID string) string {
+	parts := strings.SplitN(fullID, ":", 3)
+	if len(parts) == 3 && parts[1] == "variable" {
+		return parts[2]
+	}
+	return fullID
+}
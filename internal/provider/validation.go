@@ -89,14 +89,13 @@ func ValidateContainedIn(val types.String, diagnostics *diag.Diagnostics, fieldN
 	}
 }
 
-// ValidatePrivileges validates that privileges are valid and at least one is provided.
-// Valid privileges: read, update, execute, create
-func ValidatePrivileges(privileges types.List, diagnostics *diag.Diagnostics, fieldName string) {
+// ValidatePrivileges validates that privileges are contained in validPrivileges and at least one is provided.
+// When validPrivileges is nil any privilege name without whitespace is accepted.
+func ValidatePrivileges(privileges types.List, diagnostics *diag.Diagnostics, fieldName string, validPrivileges []string) {
 	if diagnostics == nil {
 		return
 	}
 
-	validPrivileges := []string{"read", "update", "execute", "create"}
 	validPrivilegeMap := make(map[string]bool)
 	for _, p := range validPrivileges {
 		validPrivilegeMap[p] = true
@@ -120,7 +119,19 @@ func ValidatePrivileges(privileges types.List, diagnostics *diag.Diagnostics, fi
 	}
 
 	for i, elem := range elements {
+		if elem.IsUnknown() {
+			continue
+		}
 		priv := strings.ToLower(strings.TrimSpace(elem.(types.String).ValueString()))
+		if validPrivileges == nil {
+			if priv == "" || strings.ContainsAny(priv, " \t\n") {
+				diagnostics.AddError(
+					"Invalid privilege",
+					fmt.Sprintf("%s[%d] must be a non-empty privilege name without whitespace. Got: %q", fieldName, i, elem.(types.String).ValueString()),
+				)
+			}
+			continue
+		}
 		if !validPrivilegeMap[priv] {
 			diagnostics.AddError(
 				"Invalid privilege",